@@ -0,0 +1,198 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/go-routeros/routeros"
+)
+
+// batchWindow bounds the Commands of a Batch in flight on the connection at the same time
+const batchWindow = 32
+
+/**
+ * Define Batch Result Structure: the Outcome of one Command of a Batch
+ */
+type BatchResult struct {
+	Command []string
+	Reply   *routeros.Reply
+	Err     error
+}
+
+/**
+ * Function used to get the ID returned by an ADD Command of a Batch
+ */
+func (result BatchResult) Id() string {
+
+	// If Command Failed
+	if result.Reply == nil || result.Reply.Done == nil {
+		return ""
+	}
+
+	// Return the ID From Response
+	return result.Reply.Done.Map["ret"]
+}
+
+/**
+ * Define Batch Error Structure, gathering the Errors of the failed Commands of a Batch
+ */
+type BatchError struct {
+	Failed []BatchResult
+}
+
+func (e *BatchError) Error() string {
+
+	// Initialize Messages
+	messages := make([]string, 0, len(e.Failed))
+
+	// For each Failed Command
+	for _, result := range e.Failed {
+		messages = append(messages, fmt.Sprintf("`%s`: %v", strings.Join(result.Command, " "), result.Err))
+	}
+
+	// Return Error Message
+	return fmt.Sprintf("%d command(s) failed: %s", len(e.Failed), strings.Join(messages, "; "))
+}
+
+/**
+ * Function used to Run many Commands (e.g. add/set/remove sentences) pipelined over one dedicated Session.
+ * Results are returned in the Order of the Commands; the returned Error is a *BatchError when some Commands failed.
+ */
+func (client Mikrotik) RunBatch(cmds [][]string) ([]BatchResult, error) {
+
+	// Initialize Results
+	results := make([]BatchResult, len(cmds))
+
+	// If There is Nothing to Run
+	if len(cmds) == 0 {
+		return results, nil
+	}
+
+	// Arm Connectivity Guard (a Batch always writes)
+	if err := client.armConnectivityGuard(); err != nil {
+		return nil, err
+	}
+
+	// Log Batch to be Run
+	log.Printf("[INFO] Running a batch of %d mikrotik commands", len(cmds))
+
+	// Open dedicated Session (Async mode cannot be left once entered)
+	c, err := client.dial()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+	defer c.Close()
+
+	// Switch Session to Async (tagged) mode
+	c.Async()
+
+	// Invalidate Cache for every written Menu
+	if client.cache != nil {
+		for _, cmd := range cmds {
+			client.cache.invalidate(cmd[0][:strings.LastIndex(cmd[0], "/")])
+		}
+	}
+
+	// Pipeline Commands, at most batchWindow at a time
+	var wg sync.WaitGroup
+	window := make(chan struct{}, batchWindow)
+	for i, cmd := range cmds {
+		wg.Add(1)
+		window <- struct{}{}
+		go func(i int, cmd []string) {
+			defer wg.Done()
+			defer func() { <-window }()
+
+			// Run Command
+			reply, err := c.RunArgs(cmd)
+			results[i] = BatchResult{Command: cmd, Reply: reply, Err: err}
+		}(i, cmd)
+	}
+
+	// Wait for every Reply
+	wg.Wait()
+
+	// Gather Failed Commands
+	failed := []BatchResult{}
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	// Log Batch execution result
+	log.Printf("[INFO] Batch of %d mikrotik commands done, %d failed", len(cmds), len(failed))
+
+	// If Some Commands Failed
+	if len(failed) > 0 {
+
+		// Return Results and Error
+		return results, &BatchError{Failed: failed}
+	}
+
+	// Return Results
+	return results, nil
+}
+
+/**
+ * Function used to Run ADD Commands in one Batch, returning the ID of the Item added by each Command
+ * ("" when it failed, the others are still added)
+ */
+func (client Mikrotik) batchAdd(cmds [][]string) ([]string, error) {
+
+	// Run Batch
+	results, err := client.RunBatch(cmds)
+
+	// Collect IDs
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.Id()
+	}
+
+	// Return IDs and Error
+	return ids, err
+}
+
+/**
+ * Function used to REMOVE Items of a Menu in one Batch, ignoring the Items already removed
+ */
+func (client Mikrotik) batchRemove(menu string, ids []string) error {
+
+	// Generate Mikrotik Commands
+	cmds := make([][]string, 0, len(ids))
+	for _, id := range ids {
+		cmds = append(cmds, []string{menu + "/remove", "=numbers=" + id})
+	}
+
+	// Run Batch
+	_, err := client.RunBatch(cmds)
+
+	// If There is Error
+	if batchErr, ok := err.(*BatchError); ok {
+
+		// Ignore Items already removed
+		failed := []BatchResult{}
+		for _, result := range batchErr.Failed {
+			if !IsNotFound(result.Err) {
+				failed = append(failed, result)
+			}
+		}
+
+		// If Only Missing Items Failed
+		if len(failed) == 0 {
+			return nil
+		}
+
+		// Return remaining Failures
+		return &BatchError{Failed: failed}
+	}
+
+	// Return Error
+	return err
+}
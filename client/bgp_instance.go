@@ -41,6 +41,7 @@ type BgpInstance struct {
 	RoutingTable             string `mikrotik:"routing-table"`
 	ClusterID                string `mikrotik:"cluster-id"`
 	Confederation            int    `mikrotik:"confederation"`
+	Dynamic                  bool   `mikrotik:"dynamic,readonly"`
 }
 
 // AddBgpInstance Mikrotik resource
@@ -53,7 +54,7 @@ func (client Mikrotik) AddBgpInstance(b *BgpInstance) (*BgpInstance, error) {
 	cmd := Marshal("/routing/bgp/instance/add", b)
 
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] /routing/bgp/instance/add returned %v", r)
 
 	if err != nil {
@@ -63,19 +64,28 @@ func (client Mikrotik) AddBgpInstance(b *BgpInstance) (*BgpInstance, error) {
 		return nil, err
 	}
 
-	return client.FindBgpInstance(b.Name)
+	return client.FindBgpInstance(r.Done.Map["ret"])
 }
 
 // FindBgpInstance Mikrotik resource
-func (client Mikrotik) FindBgpInstance(name string) (*BgpInstance, error) {
+func (client Mikrotik) FindBgpInstance(id string) (*BgpInstance, error) {
+	return client.findBgpInstance("?.id=", id)
+}
+
+// FindBgpInstanceByName Mikrotik resource
+func (client Mikrotik) FindBgpInstanceByName(name string) (*BgpInstance, error) {
+	return client.findBgpInstance("?name=", name)
+}
+
+func (client Mikrotik) findBgpInstance(query, value string) (*BgpInstance, error) {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return nil, err
 	}
 
-	cmd := []string{"/routing/bgp/instance/print", "?name=" + name}
+	cmd := []string{"/routing/bgp/instance/print", proplist(BgpInstance{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		if legacyBgpUnsupported(err) {
 			return nil, LegacyBgpUnsupported{}
@@ -94,7 +104,7 @@ func (client Mikrotik) FindBgpInstance(name string) (*BgpInstance, error) {
 	}
 
 	if bgpInstance.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("bgp instance `%s` not found", name))
+		return nil, NewNotFound(fmt.Sprintf("bgp instance `%s` not found", value))
 	}
 
 	return &bgpInstance, nil
@@ -112,7 +122,7 @@ func (client Mikrotik) UpdateBgpInstance(b *BgpInstance) (*BgpInstance, error) {
 	cmd := Marshal("/routing/bgp/instance/set", b)
 
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	if err != nil {
 		if legacyBgpUnsupported(err) {
@@ -121,25 +131,47 @@ func (client Mikrotik) UpdateBgpInstance(b *BgpInstance) (*BgpInstance, error) {
 		return nil, err
 	}
 
-	return client.FindBgpInstance(b.Name)
+	return client.FindBgpInstance(b.ID)
 }
 
 // DeleteBgpInstance Mikrotik resource
-func (client Mikrotik) DeleteBgpInstance(name string) error {
+func (client Mikrotik) DeleteBgpInstance(id string) error {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return err
 	}
 
-	bgpInstance, err := client.FindBgpInstance(name)
+	cmd := []string{"/routing/bgp/instance/remove", "=numbers=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	log.Printf("[DEBUG] Remove bgp instance via mikrotik api: %v", r)
+
+	return err
+}
+
+// ListBgpInstances Mikrotik resource
+func (client Mikrotik) ListBgpInstances() ([]BgpInstance, error) {
+	c, err := client.getMikrotikClient()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	cmd := []string{"/routing/bgp/instance/remove", "=numbers=" + bgpInstance.Name}
+	cmd := []string{"/routing/bgp/instance/print", proplist(BgpInstance{})}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
-	log.Printf("[DEBUG] Remove bgp instance via mikrotik api: %v", r)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		if legacyBgpUnsupported(err) {
+			return nil, LegacyBgpUnsupported{}
+		}
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found bgp instances: %v", r)
 
-	return err
+	bgpInstances := []BgpInstance{}
+	err = Unmarshal(*r, &bgpInstances)
+	if err != nil {
+		return nil, err
+	}
+
+	return bgpInstances, nil
 }
@@ -54,7 +54,7 @@ func TestAddBgpInstanceAndDeleteBgpInstance(t *testing.T) {
 		t.Errorf("The bgp instance does not match what we expected. actual: %v expected: %v", bgpInstance, expectedBgpInstance)
 	}
 
-	err = c.DeleteBgpInstance(bgpInstance.Name)
+	err = c.DeleteBgpInstance(bgpInstance.ID)
 
 	if err != nil {
 		t.Errorf("Error deleting bgp instance with: %v", err)
@@ -105,7 +105,7 @@ func TestAddAndUpdateBgpInstanceWithOptionalFieldsAndDeleteBgpInstance(t *testin
 		t.Errorf("The bgp instance does not match what we expected. actual: %v expected: %v", bgpInstance, expectedBgpInstance)
 	}
 
-	err = c.DeleteBgpInstance(bgpInstance.Name)
+	err = c.DeleteBgpInstance(bgpInstance.ID)
 
 	if err != nil {
 		t.Errorf("Error deleting bgp instance with: %v", err)
@@ -117,7 +117,7 @@ func TestFindBgpInstance_onNonExistantBgpInstance(t *testing.T) {
 	c := NewClient(GetConfigFromEnv())
 
 	name := "bgp instance does not exist"
-	_, err := c.FindBgpInstance(name)
+	_, err := c.FindBgpInstanceByName(name)
 
 	if _, ok := err.(*NotFound); !ok {
 		t.Errorf("Expecting to receive NotFound error for bgp instance `%s`, instead error was nil.", name)
@@ -35,6 +35,7 @@ type BgpPeer struct {
 	TTL                  string `mikrotik:"ttl"`
 	UpdateSource         string `mikrotik:"update-source"`
 	UseBfd               bool   `mikrotik:"use-bfd"`
+	Dynamic              bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddBgpPeer(b *BgpPeer) (*BgpPeer, error) {
@@ -47,7 +48,7 @@ func (client Mikrotik) AddBgpPeer(b *BgpPeer) (*BgpPeer, error) {
 	cmd := Marshal("/routing/bgp/peer/add", b)
 
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] /routing/bgp/peer/add returned %v", r)
 	if err != nil {
 		if legacyBgpUnsupported(err) {
@@ -56,18 +57,26 @@ func (client Mikrotik) AddBgpPeer(b *BgpPeer) (*BgpPeer, error) {
 		return nil, err
 	}
 
-	return client.FindBgpPeer(b.Name)
+	return client.FindBgpPeer(r.Done.Map["ret"])
 }
 
-func (client Mikrotik) FindBgpPeer(name string) (*BgpPeer, error) {
+func (client Mikrotik) FindBgpPeer(id string) (*BgpPeer, error) {
+	return client.findBgpPeer("?.id=", id)
+}
+
+func (client Mikrotik) FindBgpPeerByName(name string) (*BgpPeer, error) {
+	return client.findBgpPeer("?name=", name)
+}
+
+func (client Mikrotik) findBgpPeer(query, value string) (*BgpPeer, error) {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return nil, err
 	}
 
-	cmd := []string{"/routing/bgp/peer/print", "?name=" + name}
+	cmd := []string{"/routing/bgp/peer/print", proplist(BgpPeer{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		if legacyBgpUnsupported(err) {
@@ -87,7 +96,7 @@ func (client Mikrotik) FindBgpPeer(name string) (*BgpPeer, error) {
 	}
 
 	if bgpPeer.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("bgp peer `%s` not found", name))
+		return nil, NewNotFound(fmt.Sprintf("bgp peer `%s` not found", value))
 	}
 
 	return &bgpPeer, nil
@@ -103,7 +112,7 @@ func (client Mikrotik) UpdateBgpPeer(b *BgpPeer) (*BgpPeer, error) {
 	cmd := Marshal("/routing/bgp/peer/set", b)
 
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	if err != nil {
 		if legacyBgpUnsupported(err) {
@@ -112,24 +121,46 @@ func (client Mikrotik) UpdateBgpPeer(b *BgpPeer) (*BgpPeer, error) {
 		return nil, err
 	}
 
-	return client.FindBgpPeer(b.Name)
+	return client.FindBgpPeer(b.ID)
 }
 
-func (client Mikrotik) DeleteBgpPeer(name string) error {
+func (client Mikrotik) DeleteBgpPeer(id string) error {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return err
 	}
 
-	bgpPeer, err := client.FindBgpPeer(name)
+	cmd := []string{"/routing/bgp/peer/remove", "=numbers=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	log.Printf("[DEBUG] Remove bgp peer via mikrotik api: %v", r)
+
+	return err
+}
+
+// ListBgpPeers Mikrotik resource
+func (client Mikrotik) ListBgpPeers() ([]BgpPeer, error) {
+	c, err := client.getMikrotikClient()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	cmd := []string{"/routing/bgp/peer/remove", "=numbers=" + bgpPeer.Name}
+	cmd := []string{"/routing/bgp/peer/print", proplist(BgpPeer{})}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
-	log.Printf("[DEBUG] Remove bgp peer via mikrotik api: %v", r)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		if legacyBgpUnsupported(err) {
+			return nil, LegacyBgpUnsupported{}
+		}
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found bgp peers: %v", r)
 
-	return err
+	bgpPeers := []BgpPeer{}
+	err = Unmarshal(*r, &bgpPeers)
+	if err != nil {
+		return nil, err
+	}
+
+	return bgpPeers, nil
 }
@@ -22,13 +22,13 @@ func TestAddBgpPeerAndDeleteBgpPeer(t *testing.T) {
 	instanceName := "peer-test"
 	bgpPeerName := "test-peer"
 
-	_, err := c.AddBgpInstance(&BgpInstance{Name: instanceName, As: 65530, RouterID: "172.16.0.254"})
+	bgpInstance, err := c.AddBgpInstance(&BgpInstance{Name: instanceName, As: 65530, RouterID: "172.16.0.254"})
 	if err != nil {
 		t.Fatalf("unable to create BGP instance used for testing: %v", err)
 	}
-	defer func(c *Mikrotik, name string) {
-		_ = c.DeleteBgpInstance(name)
-	}(c, instanceName)
+	defer func(c *Mikrotik, id string) {
+		_ = c.DeleteBgpInstance(id)
+	}(c, bgpInstance.ID)
 
 	expectedBgpPeer := &BgpPeer{
 		Name:             bgpPeerName,
@@ -52,7 +52,7 @@ func TestAddBgpPeerAndDeleteBgpPeer(t *testing.T) {
 		t.Errorf("The bgp peer does not match what we expected. actual: %v expected: %v", bgpPeer, expectedBgpPeer)
 	}
 
-	err = c.DeleteBgpPeer(bgpPeer.Name)
+	err = c.DeleteBgpPeer(bgpPeer.ID)
 
 	if err != nil {
 		t.Errorf("Error deleting bgp peer with: %v", err)
@@ -66,13 +66,13 @@ func TestAddAndUpdateBgpPeerWithOptionalFieldsAndDeleteBgpPeer(t *testing.T) {
 	instanceName := "peer-update-test"
 	bgpPeerName := "test-peer-update"
 
-	_, err := c.AddBgpInstance(&BgpInstance{Name: instanceName, As: 65530, RouterID: "172.16.1.254"})
+	bgpInstance, err := c.AddBgpInstance(&BgpInstance{Name: instanceName, As: 65530, RouterID: "172.16.1.254"})
 	if err != nil {
 		t.Fatalf("unable to create BGP instance used for testing: %v", err)
 	}
-	defer func(c *Mikrotik, name string) {
-		_ = c.DeleteBgpInstance(name)
-	}(c, instanceName)
+	defer func(c *Mikrotik, id string) {
+		_ = c.DeleteBgpInstance(id)
+	}(c, bgpInstance.ID)
 
 	expectedBgpPeer := &BgpPeer{
 		Name:             bgpPeerName,
@@ -115,7 +115,7 @@ func TestAddAndUpdateBgpPeerWithOptionalFieldsAndDeleteBgpPeer(t *testing.T) {
 		t.Errorf("The bgp peer does not match what we expected. actual: %v expected: %v", bgpPeer, expectedBgpPeer)
 	}
 
-	err = c.DeleteBgpPeer(bgpPeer.Name)
+	err = c.DeleteBgpPeer(bgpPeer.ID)
 
 	if err != nil {
 		t.Errorf("Error deleting bgp peer with: %v", err)
@@ -127,7 +127,7 @@ func TestFindBgpPeer_onNonExistantBgpPeer(t *testing.T) {
 	c := NewClient(GetConfigFromEnv())
 
 	name := "bgp peer does not exist"
-	_, err := c.FindBgpPeer(name)
+	_, err := c.FindBgpPeerByName(name)
 
 	if _, ok := err.(*NotFound); !ok {
 		t.Errorf("Expecting to receive NotFound error for bgp peer `%s`, instead error was nil.", name)
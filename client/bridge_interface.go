@@ -13,18 +13,28 @@ type BridgeInterface struct {
 	Disabled bool   `mikrotik:"disabled"`
 	AutoMac  bool   `mikrotik:"auto-mac"`
 	AdminMac string `mikrotik:"admin-mac"`
+	Arp      string `mikrotik:"arp"`
 	Comment  string `mikrotik:"comment"`
+	Dynamic  bool   `mikrotik:"dynamic,readonly"`
 }
 
-func (client Mikrotik) FindBridgeInterface(name string) (*BridgeInterface, error) {
+func (client Mikrotik) FindBridgeInterface(id string) (*BridgeInterface, error) {
+	return client.findBridgeInterface("?.id=", id)
+}
+
+func (client Mikrotik) FindBridgeInterfaceByName(name string) (*BridgeInterface, error) {
+	return client.findBridgeInterface("?name=", name)
+}
+
+func (client Mikrotik) findBridgeInterface(query, value string) (*BridgeInterface, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/interface/bridge/print", "?name=" + name}
+	cmd := []string{"/interface/bridge/print", proplist(BridgeInterface{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -39,7 +49,7 @@ func (client Mikrotik) FindBridgeInterface(name string) (*BridgeInterface, error
 	}
 
 	if record.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("Bridge Interface `%s` not found", name))
+		return nil, NewNotFound(fmt.Sprintf("Bridge Interface `%s` not found", value))
 	}
 	return &record, nil
 }
@@ -52,13 +62,13 @@ func (client Mikrotik) AddBridgeInterface(d *BridgeInterface) (*BridgeInterface,
 
 	cmd := Marshal("/interface/bridge/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] command returned: %v", r)
 
-	return client.FindBridgeInterface(d.Name)
+	return client.FindBridgeInterface(r.Done.Map["ret"])
 }
 
 func (client Mikrotik) UpdateBridgeInterface(d *BridgeInterface) (*BridgeInterface, error) {
@@ -69,24 +79,24 @@ func (client Mikrotik) UpdateBridgeInterface(d *BridgeInterface) (*BridgeInterfa
 
 	cmd := Marshal("/interface/bridge/set", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] command returned: %v", r)
 
-	return client.FindBridgeInterface(d.Name)
+	return client.FindBridgeInterface(d.Id)
 }
 
-func (client Mikrotik) DeleteBridgeInterface(name string) error {
+func (client Mikrotik) DeleteBridgeInterface(id string) error {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return err
 	}
 
-	cmd := []string{"/interface/bridge/remove", "=numbers=" + name}
+	cmd := []string{"/interface/bridge/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return err
 	}
@@ -94,3 +104,26 @@ func (client Mikrotik) DeleteBridgeInterface(name string) error {
 
 	return nil
 }
+
+func (client Mikrotik) ListBridgeInterfaces() ([]BridgeInterface, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/interface/bridge/print", proplist(BridgeInterface{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found bridge interfaces: %v", r)
+
+	records := []BridgeInterface{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -27,18 +27,27 @@ type BridgeInterfacePort struct {
 	PointToPoint          string `mikrotik:"point-to-point"`
 	Disabled              bool   `mikrotik:"disabled"`
 	Comment               string `mikrotik:"comment"`
+	Dynamic               bool   `mikrotik:"dynamic,readonly"`
 }
 
-func (client Mikrotik) FindBridgeInterfacePort(iface string) (*BridgeInterfacePort, error) {
+func (client Mikrotik) FindBridgeInterfacePort(id string) (*BridgeInterfacePort, error) {
+	return client.findBridgeInterfacePort("?.id=", id)
+}
+
+func (client Mikrotik) FindBridgeInterfacePortByInterface(iface string) (*BridgeInterfacePort, error) {
+	return client.findBridgeInterfacePort("?interface=", iface)
+}
+
+func (client Mikrotik) findBridgeInterfacePort(query, value string) (*BridgeInterfacePort, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
 
-	cmd := []string{"/interface/bridge/port/print", "?interface=" + iface}
+	cmd := []string{"/interface/bridge/port/print", proplist(BridgeInterfacePort{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -53,7 +62,7 @@ func (client Mikrotik) FindBridgeInterfacePort(iface string) (*BridgeInterfacePo
 	}
 
 	if record.Interface == "" {
-		return nil, NewNotFound(fmt.Sprintf("Bridge Interface Port `%s` not found", iface))
+		return nil, NewNotFound(fmt.Sprintf("Bridge Interface Port `%s` not found", value))
 	}
 	return &record, nil
 }
@@ -66,13 +75,13 @@ func (client Mikrotik) AddBridgeInterfacePort(d *BridgeInterfacePort) (*BridgeIn
 
 	cmd := Marshal("/interface/bridge/port/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] command returned: %v", r)
 
-	return client.FindBridgeInterfacePort(d.Interface)
+	return client.FindBridgeInterfacePort(r.Done.Map["ret"])
 }
 
 func (client Mikrotik) UpdateBridgeInterfacePort(d *BridgeInterfacePort) (*BridgeInterfacePort, error) {
@@ -83,29 +92,24 @@ func (client Mikrotik) UpdateBridgeInterfacePort(d *BridgeInterfacePort) (*Bridg
 
 	cmd := Marshal("/interface/bridge/port/set", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] command returned: %v", r)
 
-	return client.FindBridgeInterfacePort(d.Interface)
+	return client.FindBridgeInterfacePort(d.Id)
 }
 
-func (client Mikrotik) DeleteBridgeInterfacePort(iface string) error {
+func (client Mikrotik) DeleteBridgeInterfacePort(id string) error {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return err
 	}
 
-	existingBridgeInterfaceport, err0 := client.FindBridgeInterfacePort(iface)
-	if err0 != nil {
-		return err0
-	}
-
-	cmd := []string{"/interface/bridge/port/remove", "=numbers=" + existingBridgeInterfaceport.Id}
+	cmd := []string{"/interface/bridge/port/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return err
 	}
@@ -113,3 +117,26 @@ func (client Mikrotik) DeleteBridgeInterfacePort(iface string) error {
 
 	return nil
 }
+
+func (client Mikrotik) ListBridgeInterfacePorts() ([]BridgeInterfacePort, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/interface/bridge/port/print", proplist(BridgeInterfacePort{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found bridge interface ports: %v", r)
+
+	records := []BridgeInterfacePort{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
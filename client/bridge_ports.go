@@ -0,0 +1,150 @@
+package client
+
+import (
+	"log"
+)
+
+/**
+ * Define Bridge Ports Entry Structure: the Port of an Interface in a Bridge, managed along with the other
+ * Ports of that Bridge
+ */
+type BridgePortsEntry struct {
+	Id        string `mikrotik:".id"`
+	Bridge    string `mikrotik:"bridge"`
+	Interface string `mikrotik:"interface"`
+	Comment   string `mikrotik:"comment"`
+	Disabled  bool   `mikrotik:"disabled"`
+	Dynamic   bool   `mikrotik:"dynamic,readonly"`
+}
+
+/**
+ * Function used to List the static Ports of a Bridge from Mikrotik Router
+ */
+func (client Mikrotik) ListBridgePorts(bridge string) ([]BridgePortsEntry, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running List Bridge Ports `%s`", bridge)
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := []string{"/interface/bridge/port/print", proplist(BridgePortsEntry{}), "?bridge=" + bridge}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	r, err := client.runArgs(c, cmd)
+
+	// Log Command execution result
+	log.Printf("[INFO] Bridge Ports response: `%v`", r)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Instantiate Entry Array
+	entries := []BridgePortsEntry{}
+
+	// Unmarshall Response
+	err = Unmarshal(*r, &entries)
+
+	// If There is Error (Unmarshalling)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Keep static Ports (dynamic ones are added by other features, e.g. wireless or CAPsMAN)
+	static := []BridgePortsEntry{}
+	for _, entry := range entries {
+		if !entry.Dynamic {
+			static = append(static, entry)
+		}
+	}
+
+	// Return result
+	return static, nil
+}
+
+/**
+ * Function used to ADD many Bridge Ports Entries on Mikrotik Router in one Batch
+ */
+func (client Mikrotik) AddBridgePorts(entries []BridgePortsEntry) ([]BridgePortsEntry, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running ADD of %d Bridge Ports Entries", len(entries))
+
+	// Generate Mikrotik Commands
+	cmds := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		cmds = append(cmds, Marshal("/interface/bridge/port/add", entry))
+	}
+
+	// Run Batch
+	ids, err := client.batchAdd(cmds)
+
+	// Set the IDs of the added Entries (also when others failed)
+	added := []BridgePortsEntry{}
+	for i, id := range ids {
+		if id != "" {
+			entry := entries[i]
+			entry.Id = id
+			added = append(added, entry)
+		}
+	}
+
+	// Return added Entries and Error
+	return added, err
+}
+
+/**
+ * Function used to UPDATE many Bridge Ports Entries on Mikrotik Router in one Batch
+ */
+func (client Mikrotik) UpdateBridgePorts(entries []BridgePortsEntry) error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running UPDATE of %d Bridge Ports Entries", len(entries))
+
+	// Generate Mikrotik Commands
+	cmds := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		cmd := Marshal("/interface/bridge/port/set", entry)
+
+		// Clear removed Comment (Marshal skips empty Values)
+		if entry.Comment == "" {
+			cmd = append(cmd, "=comment=")
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	// Run Batch
+	_, err := client.RunBatch(cmds)
+
+	// Return Error
+	return err
+}
+
+/**
+ * Function used to DELETE many Bridge Ports Entries from Mikrotik Router in one Batch
+ */
+func (client Mikrotik) DeleteBridgePorts(ids []string) error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running DELETE of %d Bridge Ports Entries", len(ids))
+
+	// Remove Entries in one Batch
+	return client.batchRemove("/interface/bridge/port", ids)
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"testing"
+)
+
+/**
+ * Test Method for Bridge Ports Batch ADD, UPDATE and DELETE Operations
+ */
+func TestBridgePortsBatchOperations(t *testing.T) {
+
+	// Build Client of a Fake Router
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "router", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	// Add a Port of another Bridge, which is not part of the Ports
+	menus.handle([]string{"/interface/bridge/port/add", "=bridge=other", "=interface=ether9"})
+
+	// Add Ports
+	added, err := c.AddBridgePorts([]BridgePortsEntry{
+		{Bridge: "lan", Interface: "ether2", Comment: "desk"},
+		{Bridge: "lan", Interface: "ether3"},
+	})
+	if err != nil || len(added) != 2 {
+		t.Fatalf("Error Adding Bridge Ports with: %v (%v)", err, added)
+	}
+
+	// Disable the first Port (removing its Comment) and Delete the second one
+	added[0].Disabled, added[0].Comment = true, ""
+	if err := c.UpdateBridgePorts(added[:1]); err != nil {
+		t.Fatalf("Error Updating Bridge Ports with: %v", err)
+	}
+	if err := c.DeleteBridgePorts([]string{added[1].Id}); err != nil {
+		t.Fatalf("Error Deleting Bridge Ports with: %v", err)
+	}
+
+	// Check the Ports left in the Bridge
+	found, err := c.ListBridgePorts("lan")
+	if err != nil {
+		t.Fatalf("Error Listing Bridge Ports with: %v", err)
+	}
+	if len(found) != 1 || found[0].Interface != "ether2" || !found[0].Disabled || found[0].Comment != "" {
+		t.Errorf("The bridge ports do not match what we expected. actual: %+v", found)
+	}
+}
@@ -0,0 +1,260 @@
+package client
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/go-routeros/routeros"
+	"github.com/go-routeros/routeros/proto"
+)
+
+// errNotCacheable reports a Menu that must be read from the Router
+var errNotCacheable = errors.New("menu is not cacheable")
+
+/**
+ * Define Menu Cache Structure: each Menu listing is fetched once with a single PRINT,
+ * and PRINT queries on `?property=value` are then served from the cached rows
+ */
+type menuCache struct {
+	mutex    sync.Mutex
+	listings map[string]*menuListing
+	written  map[string]bool
+}
+
+/**
+ * Define Cached Menu Listing Structure
+ */
+type menuListing struct {
+	once  sync.Once
+	reply *routeros.Reply
+	err   error
+}
+
+/**
+ * Function used to Enable the Read-Through Menu Cache. Meant for refreshes of many Items of the same Menu:
+ * once a Menu is written, it is read from the Router again.
+ */
+func (client *Mikrotik) EnableCache() {
+
+	// Initialize Cache
+	client.cache = &menuCache{
+		listings: map[string]*menuListing{},
+		written:  map[string]bool{},
+	}
+}
+
+/**
+ * Function used to Run a Command, arming the Connectivity Guard before the first write and
+ * serving PRINT queries from the Menu Cache when they are enabled
+ */
+func (client Mikrotik) runArgs(c *routeros.Client, cmd []string) (*routeros.Reply, error) {
+
+	// Split Menu and Verb
+	index := strings.LastIndex(cmd[0], "/")
+	menu, verb := cmd[0][:index], cmd[0][index+1:]
+
+	// If Command is not a PRINT
+	if verb != "print" {
+
+		// Arm Connectivity Guard
+		if err := client.armConnectivityGuard(); err != nil {
+			return nil, err
+		}
+
+		// Invalidate Cache (a write may change how other menus print, e.g. renamed interfaces)
+		if client.cache != nil {
+			client.cache.invalidate(menu)
+		}
+
+		// Run Command
+		return c.RunArgs(cmd)
+	}
+
+	// If Cache is disabled
+	if client.cache == nil {
+
+		// Run Command
+		return c.RunArgs(cmd)
+	}
+
+	// Parse PRINT Arguments
+	proplist, filters, ok := parsePrintArgs(cmd[1:])
+
+	// If Query cannot be answered from a Listing
+	if !ok {
+
+		// Run Command
+		return c.RunArgs(cmd)
+	}
+
+	// Build Listing Command
+	listCmd := []string{cmd[0]}
+	if proplist != "" {
+		listCmd = append(listCmd, "=.proplist="+proplist)
+	}
+
+	// Get Menu Listing
+	listing, err := client.cache.listing(menu, proplist, func() (*routeros.Reply, error) {
+
+		// Log Command to be Run
+		log.Printf("[INFO] Caching the mikrotik menu: `%s`", listCmd)
+
+		// Run Listing Command
+		return c.RunArgs(listCmd)
+	})
+
+	// If Menu is not cacheable
+	if err == errNotCacheable {
+
+		// Run Command
+		return c.RunArgs(cmd)
+	}
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Return Matching Rows
+	return filterReply(listing, filters), nil
+}
+
+/**
+ * Function used to Get the cached Listing of a Menu, fetching it on first use
+ */
+func (cache *menuCache) listing(menu string, proplist string, fetch func() (*routeros.Reply, error)) (*routeros.Reply, error) {
+
+	// Lock Cache
+	cache.mutex.Lock()
+
+	// If Menu was written
+	if cache.written[menu] {
+
+		// Unlock Cache
+		cache.mutex.Unlock()
+
+		// Return Error
+		return nil, errNotCacheable
+	}
+
+	// Get or Create Listing
+	key := menu + " " + proplist
+	listing, ok := cache.listings[key]
+	if !ok {
+		listing = &menuListing{}
+		cache.listings[key] = listing
+	}
+
+	// Unlock Cache
+	cache.mutex.Unlock()
+
+	// Fetch Listing once (concurrent readers wait for it)
+	listing.once.Do(func() {
+		listing.reply, listing.err = fetch()
+	})
+
+	// If Fetching Failed
+	if listing.err != nil {
+
+		// Forget Listing (the next read retries)
+		cache.mutex.Lock()
+		if cache.listings[key] == listing {
+			delete(cache.listings, key)
+		}
+		cache.mutex.Unlock()
+	}
+
+	// Return Listing
+	return listing.reply, listing.err
+}
+
+/**
+ * Function used to Invalidate the Cache after a write to a Menu
+ */
+func (cache *menuCache) invalidate(menu string) {
+
+	// Lock Cache
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	// Drop every Listing
+	cache.listings = map[string]*menuListing{}
+
+	// Read the written Menu from the Router from now on
+	cache.written[menu] = true
+}
+
+/**
+ * Function used to Parse PRINT Arguments made only of a `.proplist` and `?property=value` Queries.
+ * The Query Properties must be part of the `.proplist` for the cached Rows to be filtered.
+ */
+func parsePrintArgs(args []string) (string, []QueryFilter, bool) {
+
+	// Initialize Result
+	proplist := ""
+	filters := []QueryFilter{}
+
+	// For each Argument
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "=.proplist="):
+			proplist = strings.TrimPrefix(arg, "=.proplist=")
+		case strings.HasPrefix(arg, "?") && strings.Contains(arg, "=") && !strings.HasPrefix(arg, "?#"):
+			parts := strings.SplitN(arg[1:], "=", 2)
+			filters = append(filters, QueryFilter{Property: parts[0], Value: parts[1]})
+		default:
+			return "", nil, false
+		}
+	}
+
+	// If Proplist is set
+	if proplist != "" {
+
+		// Check every Query Property is printed
+		properties := "," + proplist + ","
+		for _, filter := range filters {
+			if !strings.Contains(properties, ","+filter.Property+",") {
+				return "", nil, false
+			}
+		}
+	}
+
+	// Return Result
+	return proplist, filters, true
+}
+
+/**
+ * Function used to Build a Reply holding the cached Rows matching every Filter
+ */
+func filterReply(listing *routeros.Reply, filters []QueryFilter) *routeros.Reply {
+
+	// Initialize Reply
+	reply := &routeros.Reply{Done: &proto.Sentence{Word: "!done", Map: map[string]string{}}}
+
+	// For each cached Row
+	for _, sentence := range listing.Re {
+
+		// Check every Filter
+		matches := true
+		for _, filter := range filters {
+			if sentence.Map[filter.Property] != filter.Value {
+				matches = false
+				break
+			}
+		}
+
+		// If Row matches
+		if matches {
+
+			// Append Row
+			reply.Re = append(reply.Re, sentence)
+		}
+	}
+
+	// Return Reply
+	return reply
+}
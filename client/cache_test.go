@@ -0,0 +1,81 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-routeros/routeros"
+	"github.com/go-routeros/routeros/proto"
+)
+
+/**
+ * Test Method for PRINT Arguments Parsing
+ */
+func TestParsePrintArgs(t *testing.T) {
+
+	// Check a Query on a printed Property
+	proplist, filters, ok := parsePrintArgs([]string{"=.proplist=.id,name", "?.id=*1"})
+	if !ok || proplist != ".id,name" || !reflect.DeepEqual(filters, []QueryFilter{{".id", "*1"}}) {
+		t.Errorf("The parsed arguments do not match what we expected. actual: %s %v %t", proplist, filters, ok)
+	}
+
+	// Check Queries that cannot be served from a Listing
+	for _, args := range [][]string{
+		{"=.proplist=.id", "?name=lan"},
+		{"?#|"},
+		{"=detail="},
+	} {
+		if _, _, ok := parsePrintArgs(args); ok {
+			t.Errorf("expected `%v` not to be cacheable", args)
+		}
+	}
+}
+
+/**
+ * Test Method for Menu Cache Listings and Invalidation
+ */
+func TestMenuCache(t *testing.T) {
+
+	// Build Cache and Listing
+	client := Mikrotik{}
+	client.EnableCache()
+	fetches := 0
+	fetch := func() (*routeros.Reply, error) {
+		fetches++
+		return &routeros.Reply{Re: []*proto.Sentence{
+			{Map: map[string]string{".id": "*1", "name": "lan"}},
+			{Map: map[string]string{".id": "*2", "name": "wan"}},
+		}}, nil
+	}
+
+	// Check the Listing is fetched once
+	for i := 0; i < 3; i++ {
+		listing, err := client.cache.listing("/interface/list", ".id,name", fetch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reply := filterReply(listing, []QueryFilter{{"name", "wan"}}); len(reply.Re) != 1 || reply.Re[0].Map[".id"] != "*2" {
+			t.Errorf("The filtered rows do not match what we expected. actual: %v", reply.Re)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("expected the listing to be fetched once, got %d", fetches)
+	}
+
+	// Check a written Menu is not cached anymore
+	client.cache.invalidate("/interface/list")
+	if _, err := client.cache.listing("/interface/list", ".id,name", fetch); err != errNotCacheable {
+		t.Errorf("expected errNotCacheable after a write, got %v", err)
+	}
+}
+
+/**
+ * Test Method for Proplist Generation
+ */
+func TestProplist(t *testing.T) {
+
+	// Check Tags (read-only Properties are printed too)
+	if actual := proplist(DhcpLease{}); actual != "=.proplist=.id,address,mac-address,client-id,server,lease-time,rate-limit,address-lists,dhcp-option,use-src-mac,comment,block-access,dynamic,host-name" {
+		t.Errorf("The proplist does not match what we expected. actual: %s", actual)
+	}
+}
@@ -1,12 +1,8 @@
 package client
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"math"
 	"os"
 	"reflect"
@@ -16,7 +12,6 @@ import (
 
 	"github.com/go-routeros/routeros"
 	"github.com/go-routeros/routeros/proto"
-	"github.com/joho/godotenv"
 )
 
 type Mikrotik struct {
@@ -27,7 +22,30 @@ type Mikrotik struct {
 	CA       string
 	Insecure bool
 
+	// CAPem is an inline CA bundle, trusted along with the CA file
+	CAPem string
+	// ClientCertificate and ClientKey are a PEM path or inline PEM, for routers requiring client certificates
+	ClientCertificate string
+	ClientKey         string
+	// ServerName overrides the name the router certificate is verified against
+	ServerName string
+	// MinTLSVersion is the lowest TLS version accepted (1.0, 1.1, 1.2 or 1.3)
+	MinTLSVersion string
+
+	// Port is the API port, 8728 (or 8729 with TLS) when neither set here nor in Host
+	Port int
+	// ConnectTimeout bounds connecting, the TLS handshake and the login (no bound when 0)
+	ConnectTimeout time.Duration
+	// KeepAlive is the TCP keepalive period, as in net.Dialer (disabled when negative)
+	KeepAlive time.Duration
+	// BindAddress is the source address of connections
+	BindAddress string
+	// Dialer opens connections instead of a net.Dialer built from the settings above
+	Dialer Dialer
+
 	connection *routeros.Client
+	cache      *menuCache
+	guard      *connectivityGuard
 }
 
 func Unmarshal(reply routeros.Reply, v interface{}) error {
@@ -88,8 +106,7 @@ func parseStruct(v *reflect.Value, sentence proto.Sentence) {
 				case reflect.String:
 					field.SetString(pair.Value)
 				case reflect.Bool:
-					b, _ := strconv.ParseBool(pair.Value)
-					field.SetBool(b)
+					field.SetBool(mikrotikBoolToBool(pair.Value))
 				case reflect.Int:
 					if contains(tags, "ttlToSeconds") {
 						field.SetInt(int64(ttlToSeconds(pair.Value)))
@@ -158,95 +175,60 @@ func NewClient(host, username, password string, tls bool, caCertificate string,
 }
 
 /**
- * Function used to Load Client Configuration from Environment Variables
+ * Function used to Load Client Configuration from `MIKROTIK_*` Environment Variables, falling back to
+ * the Credentials Profile named by `MIKROTIK_PROFILE` (the default Profile when unset)
  */
-func GetConfigFromEnv() (host, username, password string, tls bool, caCertificate string, insecure bool) {
-
-	// Load .env File if exists
-	envFile, _ := godotenv.Read("../.env")
+func LoadConfigFromEnv() (host, username, password string, tls bool, caCertificate string, insecure bool, err error) {
 
-	// Initialize Host
-	host = os.Getenv("MIKROTIK_HOST")
+	// Load Profile
+	profile, err := EnvProfile()
 
-	// If Host is Empty
-	if host == "" {
-
-		// Initialize from .env
-		host = envFile["MIKROTIK_HOST"]
-	}
-
-	// Initialize username
-	username = os.Getenv("MIKROTIK_USER")
-
-	// If Username is Empty
-	if username == "" {
-
-		// Initialize from .env
-		username = envFile["MIKROTIK_USER"]
-	}
-
-	// Initialize Password
-	password = os.Getenv("MIKROTIK_PASSWORD")
-
-	// If Password is Empty
-	if password == "" {
-
-		// Initialize from .env
-		password = envFile["MIKROTIK_PASSWORD"]
-	}
-
-	// Initialize TLS
-	tlsString := os.Getenv("MIKROTIK_TLS")
-
-	// If Tls String is Empty
-	if tlsString == "" {
+	// If There is Error
+	if err != nil {
 
-		// Initialize from .env
-		tlsString = envFile["MIKROTIK_TLS"]
+		// Return Error
+		return "", "", "", false, "", false, err
 	}
 
-	// Check tlsString is "true"
-	if tlsString == "true" {
-
-		// Initialize TLS to "true"
-		tls = true
-
+	// Initialize Connection Settings
+	host = profile.Setting("MIKROTIK_HOST", "host")
+	username = profile.Setting("MIKROTIK_USER", "username")
+	tls = profile.Setting("MIKROTIK_TLS", "tls") == "true"
+	caCertificate = profile.Setting("MIKROTIK_CA_CERTIFICATE", "ca_certificate")
+	insecure = profile.Setting("MIKROTIK_INSECURE", "insecure") == "true"
+
+	// Initialize Password (set inline or in a File, a File of the Profile is relative to the Credentials File)
+	if value, ok := os.LookupEnv("MIKROTIK_PASSWORD"); ok {
+		password = value
+	} else if file, ok := os.LookupEnv("MIKROTIK_PASSWORD_FILE"); ok {
+		password, err = ReadPasswordFile(file)
 	} else {
-
-		// Initialize TLS to "false"
-		tls = false
+		password, err = profile.Password()
 	}
 
-	// Initialize CA certificate
-	caCertificate = os.Getenv("MIKROTIK_CA_CERTIFICATE")
-
-	// If caCertificate is Empty
-	if caCertificate == "" {
-
-		// Initialize from .env
-		caCertificate = envFile["MIKROTIK_CA_CERTIFICATE"]
-	}
-
-	// Initialize Insecure String
-	insecureString := os.Getenv("MIKROTIK_INSECURE")
-
-	// If insecureString is Empty
-	if insecureString == "" {
+	// If There is Error
+	if err != nil {
 
-		// Initialize from .env
-		insecureString = envFile["MIKROTIK_INSECURE"]
+		// Return Error
+		return "", "", "", false, "", false, err
 	}
 
-	// If insecureString is "true"
-	if insecureString == "true" {
+	// Return All Variables
+	return host, username, password, tls, caCertificate, insecure, nil
+}
 
-		// Initialize "insecure" to "true"
-		insecure = true
+/**
+ * Function used to Load Client Configuration like LoadConfigFromEnv, panicking when the Configuration
+ * cannot be loaded (e.g. a misspelled `MIKROTIK_PROFILE` or a malformed Credentials File)
+ */
+func GetConfigFromEnv() (host, username, password string, tls bool, caCertificate string, insecure bool) {
 
-	} else {
+	// Load Configuration
+	host, username, password, tls, caCertificate, insecure, err := LoadConfigFromEnv()
 
-		// Initialize "insecure" to "false"
-		insecure = false
+	// If There is Error
+	if err != nil {
+		panic(err)
 	}
 
 	// Return All Variables
@@ -258,38 +240,8 @@ func (client *Mikrotik) getMikrotikClient() (*routeros.Client, error) {
 		return client.connection, nil
 	}
 
-	address := client.Host
-	username := client.Username
-	password := client.Password
-
-	var mikrotikClient *routeros.Client
-	var err error
-
-	if client.TLS {
-		var tlsCfg tls.Config
-		tlsCfg.InsecureSkipVerify = client.Insecure
-
-		if client.CA != "" {
-			certPool := x509.NewCertPool()
-			file, err := ioutil.ReadFile(client.CA)
-			if err != nil {
-				log.Printf("[ERROR] Failed to read CA file %s: %v", client.CA, err)
-				return nil, err
-			}
-			certPool.AppendCertsFromPEM(file)
-			tlsCfg.RootCAs = certPool
-		}
-
-		mikrotikClient, err = routeros.DialTLS(address, username, password, &tlsCfg)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		mikrotikClient, err = routeros.Dial(address, username, password)
-	}
-
+	mikrotikClient, err := client.dial()
 	if err != nil {
-		log.Printf("[ERROR] Failed to login to routerOS with error: %v", err)
 		return nil, err
 	}
 
@@ -306,6 +258,53 @@ func boolToMikrotikBool(b bool) string {
 	}
 }
 
+func mikrotikBoolToBool(s string) bool {
+	if s == "yes" {
+		return true
+	}
+	b, _ := strconv.ParseBool(s)
+	return b
+}
+
+func isMikrotikBool(s string) bool {
+	switch s {
+	case "yes", "no", "true", "false":
+		return true
+	}
+	return false
+}
+
+/**
+ * Function used to Build the `.proplist` Argument restricting a PRINT to the Properties a Struct declares
+ */
+func proplist(s interface{}) string {
+
+	// Get Struct Type
+	t := reflect.TypeOf(s)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Initialize Properties
+	properties := []string{}
+
+	// For each Field
+	for i := 0; i < t.NumField(); i++ {
+
+		// Get MikroTik Name (falls back on the lower cased Field Name, like Unmarshal)
+		name := strings.Split(t.Field(i).Tag.Get("mikrotik"), ",")[0]
+		if name == "" {
+			name = strings.ToLower(t.Field(i).Name)
+		}
+
+		// Append Property
+		properties = append(properties, name)
+	}
+
+	// Return Argument
+	return "=.proplist=" + strings.Join(properties, ",")
+}
+
 func Marshal(c string, s interface{}) []string {
 	var elem reflect.Value
 	rv := reflect.ValueOf(s)
@@ -323,7 +322,13 @@ func Marshal(c string, s interface{}) []string {
 		value := elem.Field(i)
 		fieldType := elem.Type().Field(i)
 		// supports multiple struct tags--assumes first is mikrotik field name
-		tag := strings.Split(fieldType.Tag.Get("mikrotik"), ",")[0]
+		tags := strings.Split(fieldType.Tag.Get("mikrotik"), ",")
+		tag := tags[0]
+
+		// readonly fields are read from the router but never sent to it
+		if contains(tags, "readonly") {
+			continue
+		}
 
 		if tag != "" && (!value.IsZero() || value.Kind() == reflect.Bool) {
 			switch value.Kind() {
@@ -276,6 +276,22 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestMarshal_readonly(t *testing.T) {
+	action := "/test/lease/add"
+	testStruct := struct {
+		Address  string `mikrotik:"address"`
+		Dynamic  bool   `mikrotik:"dynamic,readonly"`
+		HostName string `mikrotik:"host-name,readonly"`
+	}{"10.0.0.1", true, "laptop"}
+
+	expectedCmd := []string{action, "=address=10.0.0.1"}
+	cmd := Marshal(action, &testStruct)
+
+	if !reflect.DeepEqual(cmd, expectedCmd) {
+		t.Errorf("Read-only fields should not be marshaled: %v does not equal expected %v", cmd, expectedCmd)
+	}
+}
+
 func TestMarshalStructWithoutTags(t *testing.T) {
 	action := "/test/owner/add"
 	name := "test owner"
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// ConnectivityGuardName names the Backup, Script and Scheduler of the Connectivity Guard
+const ConnectivityGuardName = "terraform-connectivity-guard"
+
+// connectivityGuardPolicies lists the Script Policies needed to load a Backup
+var connectivityGuardPolicies = []string{"ftp", "reboot", "read", "write", "policy", "test", "password", "sensitive"}
+
+/**
+ * Define Connectivity Guard Structure: before the first Mutation of an Apply, a Backup is saved and a Scheduler
+ * armed to load it after the Timeout. As soon as no Change runs anymore, the Scheduler is removed over a new
+ * Connection: if the Router cannot be reached anymore, it rolls back. A later Change of the same Apply arms the
+ * Scheduler again, against the same Backup.
+ */
+type connectivityGuard struct {
+	timeout time.Duration
+	mutex   sync.Mutex
+	holds   int
+	saved   bool
+	armed   bool
+	err     error
+}
+
+/**
+ * Function used to Enable the Connectivity Guard, rolling the Router back if it cannot be reached
+ * again within the Timeout after a Mutation
+ */
+func (client *Mikrotik) EnableConnectivityGuard(timeout time.Duration) {
+
+	// Initialize Guard
+	client.guard = &connectivityGuard{timeout: timeout}
+}
+
+/**
+ * Function used to Hold the Connectivity Guard during a Change (e.g. the Create of a Resource). The returned
+ * Function releases it: once no Change holds the Guard anymore, an armed Guard is disarmed over a new
+ * Connection, as an established Session outlives Firewall Rules blocking new ones. The Error tells that the
+ * Router could not be reached to do so, the Guard then stays armed and the Router rolls back.
+ */
+func (client Mikrotik) HoldConnectivityGuard() func() error {
+
+	// If Guard is disabled
+	if client.guard == nil {
+		return func() error { return nil }
+	}
+
+	// Count Hold
+	client.guard.mutex.Lock()
+	client.guard.holds++
+	client.guard.mutex.Unlock()
+
+	// Build and Return Release Function
+	return func() error {
+		client.guard.mutex.Lock()
+		defer client.guard.mutex.Unlock()
+
+		// Count Release
+		client.guard.holds--
+
+		// If another Change still holds the Guard, or it is not armed
+		if client.guard.holds > 0 || !client.guard.armed {
+			return nil
+		}
+
+		// Reconnect to the Router
+		c, err := client.dial()
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error (the Guard stays armed)
+			return fmt.Errorf("cannot reconnect to the router to disarm the connectivity guard, it rolls back in %s: %w", client.guard.timeout, err)
+		}
+		defer c.Close()
+
+		// Remove Scheduler over the new Connection (without the Cache, which may hold the Guard Objects of the old one)
+		reconnected := client.unguarded()
+		reconnected.connection, reconnected.cache = c, nil
+		if err := reconnected.unschedule(); err != nil {
+
+			// Return Error (the Guard stays armed)
+			return fmt.Errorf("cannot disarm the connectivity guard, the router rolls back in %s: %w", client.guard.timeout, err)
+		}
+
+		// Log Disarming
+		log.Printf("[INFO] Connectivity guard disarmed")
+
+		// Mark Guard as Disarmed (the next Change arms it again)
+		client.guard.armed = false
+
+		// Return no Error
+		return nil
+	}
+}
+
+/**
+ * Function used to Remove the Backup and Script of the Connectivity Guard once the Apply is done. The
+ * Rollback was already cancelled when the last Change was released: this only cleans the Router up, and
+ * leaves a Guard that could not be disarmed in place.
+ */
+func (client Mikrotik) RemoveConnectivityGuard() error {
+
+	// If Guard is disabled
+	if client.guard == nil {
+		return nil
+	}
+
+	// Lock Guard
+	client.guard.mutex.Lock()
+	defer client.guard.mutex.Unlock()
+
+	// If nothing was saved, or the Router still has to roll back
+	if !client.guard.saved || client.guard.armed {
+		return nil
+	}
+
+	// Reconnect to the Router
+	c, err := client.dial()
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return fmt.Errorf("cannot reconnect to the router to remove the connectivity guard backup: %w", err)
+	}
+	defer c.Close()
+
+	// Remove Script and Backup over the new Connection
+	reconnected := client.unguarded()
+	reconnected.connection, reconnected.cache = c, nil
+	if err := reconnected.disarm(); err != nil {
+
+		// Return Error
+		return fmt.Errorf("cannot remove the connectivity guard backup: %w", err)
+	}
+
+	// Mark Backup as Removed
+	client.guard.saved = false
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Arm the Connectivity Guard, before a Mutation
+ */
+func (client Mikrotik) armConnectivityGuard() error {
+
+	// If Guard is disabled
+	if client.guard == nil {
+		return nil
+	}
+
+	// Arm Guard (concurrent Mutations wait for it, an Arming Error is kept)
+	client.guard.mutex.Lock()
+	defer client.guard.mutex.Unlock()
+	if !client.guard.armed && client.guard.err == nil {
+
+		// Save the Backup once per Apply, then only arm the Scheduler again
+		if !client.guard.saved {
+			client.guard.err = client.unguarded().arm(client.guard.timeout)
+			client.guard.saved = client.guard.err == nil
+		} else {
+			client.guard.err = client.unguarded().schedule(client.guard.timeout)
+		}
+		client.guard.armed = client.guard.err == nil
+	}
+
+	// Return Arming Error (no Mutation is run without the Guard)
+	if client.guard.err != nil {
+		return fmt.Errorf("cannot arm connectivity guard: %w", client.guard.err)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Save the Rollback Backup and Arm the Scheduler loading it after the Timeout
+ */
+func (client Mikrotik) arm(timeout time.Duration) error {
+
+	// Log Arming
+	log.Printf("[INFO] Arming connectivity guard: the router rolls back in %s unless disarmed", timeout)
+
+	// Remove Leftovers of a previous Guard
+	if err := client.disarm(); err != nil {
+		return err
+	}
+
+	// Save Backup of the current Configuration
+	if _, err := client.SaveSystemBackup(&SystemBackup{Name: ConnectivityGuardName, DontEncrypt: true}); err != nil {
+		return err
+	}
+
+	// Create Rollback Script (it cancels its Scheduler first, so that a failed Load is not retried forever)
+	source := fmt.Sprintf(
+		"/system scheduler remove [find name=\"%s\"]; /system backup load name=\"%s.backup\" password=\"\"",
+		ConnectivityGuardName, ConnectivityGuardName,
+	)
+	if _, err := client.CreateScript(ConnectivityGuardName, client.Username, source, connectivityGuardPolicies, false); err != nil {
+		return err
+	}
+
+	// Arm Scheduler
+	return client.schedule(timeout)
+}
+
+/**
+ * Function used to Arm the Scheduler running the Rollback Script after the Timeout
+ */
+func (client Mikrotik) schedule(timeout time.Duration) error {
+
+	// Create Scheduler
+	_, err := client.CreateScheduler(&Scheduler{
+		Name:     ConnectivityGuardName,
+		OnEvent:  ConnectivityGuardName,
+		Interval: int(timeout.Seconds()),
+	})
+
+	// Return Error
+	return err
+}
+
+/**
+ * Function used to Remove the Scheduler of the Connectivity Guard, if any (the Rollback cannot fire anymore)
+ */
+func (client Mikrotik) unschedule() error {
+
+	// Find and Remove Scheduler
+	if scheduler, err := client.FindSchedulerByName(ConnectivityGuardName); err == nil {
+		if err := client.DeleteScheduler(scheduler.Id); err != nil && !IsNotFound(err) {
+			return err
+		}
+	} else if !IsNotFound(err) {
+		return err
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Remove the Scheduler, Script and Backup of the Connectivity Guard, if any
+ */
+func (client Mikrotik) disarm() error {
+
+	// Remove Scheduler first (the Rollback cannot fire anymore)
+	if err := client.unschedule(); err != nil {
+		return err
+	}
+
+	// Remove Script
+	if script, err := client.FindScriptByName(ConnectivityGuardName); err == nil {
+		if err := client.DeleteScript(script.Id); err != nil && !IsNotFound(err) {
+			return err
+		}
+	} else if !IsNotFound(err) {
+		return err
+	}
+
+	// Remove Backup File
+	if err := client.DeleteFile(ConnectivityGuardName + ".backup"); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to get a Copy of the Client whose Mutations do not arm the Connectivity Guard
+ */
+func (client Mikrotik) unguarded() Mikrotik {
+
+	// Drop Guard from the Copy
+	client.guard = nil
+
+	// Return Copy
+	return client
+}
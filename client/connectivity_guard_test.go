@@ -0,0 +1,219 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+/**
+ * Test Method for a Connectivity Guard that cannot be armed
+ */
+func TestConnectivityGuardBlocksUnguardedWrites(t *testing.T) {
+
+	// Build Client of an unreachable Router
+	c := Mikrotik{Host: "127.0.0.1:1", Username: "admin"}
+	c.EnableConnectivityGuard(5 * time.Minute)
+
+	// Run a Write twice (the Guard is armed once, the Arming Error is kept)
+	for i := 0; i < 2; i++ {
+
+		// Run Write (the Command must not reach the nil Session)
+		_, err := c.runArgs(nil, []string{"/ip/pool/add", "=name=guarded"})
+
+		// Check Error
+		if err == nil || !strings.HasPrefix(err.Error(), "cannot arm connectivity guard") {
+			t.Fatalf("The write was not blocked by the connectivity guard: %v", err)
+		}
+	}
+
+	// Check Releasing and Removing a Guard never armed does nothing
+	if err := c.HoldConnectivityGuard()(); err != nil {
+		t.Errorf("Disarming an unarmed connectivity guard failed with: %v", err)
+	}
+	if err := c.RemoveConnectivityGuard(); err != nil {
+		t.Errorf("Removing an unarmed connectivity guard failed with: %v", err)
+	}
+}
+
+/**
+ * Build a Client of a Fake Router with the Connectivity Guard enabled, and a Function counting the Guard
+ * Objects on the Router
+ */
+func guardedFakeRouter() (*Mikrotik, *fakeRouter, func() int) {
+
+	// Build Client of a Fake Router
+	menus := &fakeMenus{}
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+
+		// Store Scheduler Interval as the Router prints it
+		if command[0] == "/system/scheduler/add" {
+			for i, word := range command {
+				if word == "=interval=300" {
+					command[i] = "=interval=5m"
+				}
+			}
+		}
+		return menus.handle(command)
+	}}
+	c := &Mikrotik{Host: "router", Username: "admin", Dialer: router}
+	c.EnableConnectivityGuard(5 * time.Minute)
+
+	// Function used to Count the Guard Objects on the Router
+	guardObjects := func() int {
+		count := 0
+		for _, query := range [][]string{
+			{"/system/scheduler/print", "?name=" + ConnectivityGuardName},
+			{"/system/script/print", "?name=" + ConnectivityGuardName},
+			{"/file/print", "?name=" + ConnectivityGuardName + ".backup"},
+		} {
+			rows, _ := menus.handle(query)
+			count += len(rows)
+		}
+		return count
+	}
+
+	// Return Client, Router and Counter
+	return c, router, guardObjects
+}
+
+/**
+ * Test Method for Saving the Connectivity Guard Backup once per Apply and Disarming it over a new Connection
+ * as soon as no Change runs anymore
+ */
+func TestConnectivityGuardCycle(t *testing.T) {
+
+	// Build Client of a Fake Router
+	c, router, guardObjects := guardedFakeRouter()
+
+	// Check Nothing is armed before the first Write
+	if count := guardObjects(); count != 0 {
+		t.Fatalf("The connectivity guard was armed before any write: %d objects", count)
+	}
+
+	// Hold Guard in two concurrent Changes
+	release, other := c.HoldConnectivityGuard(), c.HoldConnectivityGuard()
+
+	// Run two Writes
+	session, err := c.getMikrotikClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, command := range [][]string{{"/ip/pool/add", "=name=guarded"}, {"/ip/pool/remove", "=numbers=guarded"}} {
+		if _, err := c.runArgs(session, command); err != nil {
+			t.Fatalf("The guarded write failed with: %v", err)
+		}
+	}
+
+	// Check Backup, Script and Scheduler were armed once, before the first Write
+	if count := guardObjects(); count != 3 {
+		t.Fatalf("The connectivity guard left %d objects instead of a backup, a script and a scheduler", count)
+	}
+	written, backups := -1, 0
+	for i, command := range router.commands {
+		if command[0] == "/ip/pool/add" {
+			written = i
+		}
+		if command[0] == "/system/scheduler/add" && written >= 0 {
+			t.Fatalf("The scheduler was armed after the write")
+		}
+		if command[0] == "/system/backup/save" {
+			backups++
+		}
+	}
+	if backups != 1 {
+		t.Fatalf("The connectivity guard saved %d backups during one apply", backups)
+	}
+	if scheduler, err := c.FindSchedulerByName(ConnectivityGuardName); err != nil || scheduler.OnEvent != ConnectivityGuardName {
+		t.Fatalf("The scheduler does not run the rollback script: %v, %v", scheduler, err)
+	}
+
+	// Check Releasing one Change keeps the Guard armed
+	if err := release(); err != nil {
+		t.Fatalf("Releasing the connectivity guard failed with: %v", err)
+	}
+	if count := guardObjects(); count != 3 {
+		t.Fatalf("The connectivity guard was disarmed while a change still runs")
+	}
+
+	// Check Releasing the last Change removes the Scheduler over a new Connection
+	dialed := len(router.dialed)
+	if err := other(); err != nil {
+		t.Fatalf("Disarming the connectivity guard failed with: %v", err)
+	}
+	if len(router.dialed) != dialed+1 {
+		t.Fatalf("The connectivity guard was disarmed without reconnecting to the router")
+	}
+	if _, err := c.FindSchedulerByName(ConnectivityGuardName); !IsNotFound(err) {
+		t.Fatalf("The scheduler was not removed once the last change was released: %v", err)
+	}
+
+	// Check the next Change arms the Scheduler again against the same Backup
+	release = c.HoldConnectivityGuard()
+	if _, err := c.runArgs(session, []string{"/ip/pool/add", "=name=later"}); err != nil {
+		t.Fatalf("The guarded write failed with: %v", err)
+	}
+	backups = 0
+	for _, command := range router.commands {
+		if command[0] == "/system/backup/save" {
+			backups++
+		}
+	}
+	if count := guardObjects(); count != 3 || backups != 1 {
+		t.Fatalf("The connectivity guard was not armed again with the first backup: %d objects, %d backups", count, backups)
+	}
+	if err := release(); err != nil {
+		t.Fatalf("Disarming the connectivity guard failed with: %v", err)
+	}
+
+	// Check Removing the Guard once the Apply is done cleans the Router up
+	if err := c.RemoveConnectivityGuard(); err != nil {
+		t.Fatalf("Removing the connectivity guard failed with: %v", err)
+	}
+	if count := guardObjects(); count != 0 {
+		t.Fatalf("The connectivity guard left %d objects once removed", count)
+	}
+
+	// Check Removing again does nothing
+	dialed = len(router.dialed)
+	if err := c.RemoveConnectivityGuard(); err != nil || len(router.dialed) != dialed {
+		t.Fatalf("Removing a removed connectivity guard reached the router: %v", err)
+	}
+}
+
+/**
+ * Test Method for a Connectivity Guard left armed when the Router cannot be reached again
+ */
+func TestConnectivityGuardStaysArmedWhenUnreachable(t *testing.T) {
+
+	// Build Client of a Fake Router
+	c, router, guardObjects := guardedFakeRouter()
+
+	// Run a Write in a Change
+	release := c.HoldConnectivityGuard()
+	session, err := c.getMikrotikClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.runArgs(session, []string{"/ip/pool/add", "=name=guarded"}); err != nil {
+		t.Fatalf("The guarded write failed with: %v", err)
+	}
+
+	// Lock the Provider out (the established Session still works)
+	router.refuse = errors.New("connection refused")
+
+	// Check Releasing the Change fails and leaves the Rollback armed
+	if err := release(); err == nil || !strings.Contains(err.Error(), "rolls back") {
+		t.Fatalf("Disarming an unreachable router did not fail: %v", err)
+	}
+	if count := guardObjects(); count != 3 {
+		t.Fatalf("The connectivity guard was disarmed over the old session: %d objects left", count)
+	}
+
+	// Check Removing the Guard once the Apply is done leaves the Rollback in place
+	router.refuse = nil
+	if err := c.RemoveConnectivityGuard(); err != nil || guardObjects() != 3 {
+		t.Fatalf("The connectivity guard of a router that rolls back was removed: %v", err)
+	}
+}
@@ -0,0 +1,296 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultProfile names the Profile used when none is requested
+const DefaultProfile = "default"
+
+/**
+ * Define Credentials Profile Structure: one `[name]` Section of a Credentials File, e.g.
+ *
+ *	[branch-1]
+ *	host          = 10.1.0.1
+ *	username      = terraform
+ *	password_file = ~/.secrets/branch-1
+ *	tls           = true
+ */
+type Profile struct {
+	Name   string
+	File   string
+	values map[string]string
+}
+
+/**
+ * Function used to get the Credentials Files looked up, in Order: `MIKROTIK_CREDENTIALS_FILE`,
+ * `~/.config/mikrotik/credentials` (the User Configuration Directory) and `~/.mikrotik/credentials`
+ */
+func CredentialsFiles() []string {
+
+	// Initialize Files
+	files := []string{}
+
+	// Add File set in Environment
+	if file := os.Getenv("MIKROTIK_CREDENTIALS_FILE"); file != "" {
+		files = append(files, expandHome(file))
+	}
+
+	// Add File in User Configuration Directory
+	if dir, err := os.UserConfigDir(); err == nil {
+		files = append(files, filepath.Join(dir, "mikrotik", "credentials"))
+	}
+
+	// Add File in Home Directory
+	if home, err := os.UserHomeDir(); err == nil {
+		files = append(files, filepath.Join(home, ".mikrotik", "credentials"))
+	}
+
+	// Return Files
+	return files
+}
+
+/**
+ * Function used to Load a Profile from the first existing Credentials File. The default Profile
+ * is empty when there is no such File or Section, a named one must exist.
+ */
+func LoadProfile(name string) (*Profile, error) {
+
+	// Default Profile Name
+	requested := name != ""
+	if !requested {
+		name = DefaultProfile
+	}
+
+	// For each Credentials File
+	for _, file := range CredentialsFiles() {
+
+		// Open File
+		reader, err := os.Open(file)
+
+		// If File does not exist
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return nil, fmt.Errorf("credentials file %s: %w", file, err)
+		}
+
+		// Parse File
+		profiles, err := parseCredentials(reader)
+		reader.Close()
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return nil, fmt.Errorf("credentials file %s: %w", file, err)
+		}
+
+		// If Profile is missing
+		if _, ok := profiles[name]; !ok && requested {
+
+			// Return Error
+			return nil, fmt.Errorf("profile `%s` not found in credentials file %s", name, file)
+		}
+
+		// Return Profile (the first File wins)
+		return &Profile{Name: name, File: file, values: profiles[name]}, nil
+	}
+
+	// If a named Profile was requested
+	if requested {
+
+		// Return Error
+		return nil, fmt.Errorf("profile `%s` requested but no credentials file found in %v", name, CredentialsFiles())
+	}
+
+	// Return empty Profile
+	return &Profile{Name: name, values: map[string]string{}}, nil
+}
+
+/**
+ * Function used to Get a Value of the Profile ("" when not set)
+ */
+func (profile *Profile) Get(key string) string {
+
+	// Return Value
+	return profile.values[key]
+}
+
+/**
+ * Function used to Get the Password of the Profile: `password`, or the contents of `password_file`
+ * (a relative Path is relative to the Credentials File)
+ */
+func (profile *Profile) Password() (string, error) {
+
+	// If Password is inline
+	if password, ok := profile.values["password"]; ok {
+		return password, nil
+	}
+
+	// If Password is in a File
+	if file := profile.values["password_file"]; file != "" {
+		if !filepath.IsAbs(file) && !strings.HasPrefix(file, "~/") && profile.File != "" {
+			file = filepath.Join(filepath.Dir(profile.File), file)
+		}
+		return ReadPasswordFile(file)
+	}
+
+	// Return no Password
+	return "", nil
+}
+
+/**
+ * Function used to Read a Password File (the trailing Newline is dropped, a leading `~/` is the Home Directory)
+ */
+func ReadPasswordFile(file string) (string, error) {
+
+	// Read File
+	contents, err := ioutil.ReadFile(expandHome(file))
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return "", fmt.Errorf("password_file: %w", err)
+	}
+
+	// Return Password
+	return strings.TrimRight(string(contents), "\r\n"), nil
+}
+
+/**
+ * Function used to Load the Profile named by `MIKROTIK_PROFILE` (the default Profile when unset)
+ */
+func EnvProfile() (*Profile, error) {
+
+	// Load Profile
+	return LoadProfile(os.Getenv("MIKROTIK_PROFILE"))
+}
+
+/**
+ * Function used to Get a Setting from its Environment Variable (even when set empty), or else
+ * from the Profile
+ */
+func (profile *Profile) Setting(env string, key string) string {
+
+	// If Environment Variable is set
+	if value, ok := os.LookupEnv(env); ok {
+		return value
+	}
+
+	// Return Profile Value
+	return profile.Get(key)
+}
+
+/**
+ * Function used to Get a Setting from its Environment Variable (even when set empty), or else
+ * from the Profile named by `MIKROTIK_PROFILE`
+ */
+func Setting(env string, key string) (string, error) {
+
+	// If Environment Variable is set
+	if value, ok := os.LookupEnv(env); ok {
+		return value, nil
+	}
+
+	// Load Profile
+	profile, err := EnvProfile()
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return "", err
+	}
+
+	// Return Profile Value
+	return profile.Get(key), nil
+}
+
+/**
+ * Function used to Parse a Credentials File: `[profile]` Sections of `key = value` Lines (Values may
+ * be double quoted). Lines before the first Section belong to the default Profile.
+ */
+func parseCredentials(r io.Reader) (map[string]map[string]string, error) {
+
+	// Initialize Profiles
+	profiles := map[string]map[string]string{}
+	section := DefaultProfile
+
+	// For each Line
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		// Skip Blank Lines and Comments
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		// If Line opens a Section
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[section]; !ok {
+				profiles[section] = map[string]string{}
+			}
+			continue
+		}
+
+		// Split Key and Value
+		index := strings.Index(line, "=")
+		if index < 1 {
+			return nil, fmt.Errorf("line %d: expected `key = value`", number)
+		}
+		key, value := strings.TrimSpace(line[:index]), strings.TrimSpace(line[index+1:])
+
+		// Unquote Value
+		if strings.HasPrefix(value, `"`) {
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", number, err)
+			}
+			value = unquoted
+		}
+
+		// Set Value (`user` is a Shorthand of `username`)
+		if key == "user" {
+			key = "username"
+		}
+		if profiles[section] == nil {
+			profiles[section] = map[string]string{}
+		}
+		profiles[section][key] = value
+	}
+
+	// Return Profiles
+	return profiles, scanner.Err()
+}
+
+/**
+ * Function used to expand a leading `~/` to the Home Directory
+ */
+func expandHome(path string) string {
+
+	// If Path is in the Home Directory
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, path[2:])
+		}
+	}
+
+	// Return Path
+	return path
+}
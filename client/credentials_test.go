@@ -0,0 +1,136 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+/**
+ * Function used to Unset an Environment Variable for the Duration of a Test
+ */
+func unsetenv(t *testing.T, key string) {
+	if value, ok := os.LookupEnv(key); ok {
+		os.Unsetenv(key)
+		t.Cleanup(func() { os.Setenv(key, value) })
+	}
+}
+
+/**
+ * Function used to write a Credentials File used by the Test
+ */
+func testCredentialsFile(t *testing.T, contents string) string {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "credentials")
+	if err := ioutil.WriteFile(file, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("MIKROTIK_CREDENTIALS_FILE", file)
+	return dir
+}
+
+/**
+ * Test Method for Credentials File Parsing
+ */
+func TestParseCredentials(t *testing.T) {
+
+	// Parse File
+	profiles, err := parseCredentials(strings.NewReader(`
+host = 192.168.88.1
+# comment
+[branch-1]
+host     = "10.1.0.1"
+user     = terraform
+password = "p=ss \"word\""
+; comment
+tls      = true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check Profiles
+	expected := map[string]map[string]string{
+		"default":  {"host": "192.168.88.1"},
+		"branch-1": {"host": "10.1.0.1", "username": "terraform", "password": `p=ss "word"`, "tls": "true"},
+	}
+	if !reflect.DeepEqual(profiles, expected) {
+		t.Errorf("The profiles do not match what we expected. actual: %v expected: %v", profiles, expected)
+	}
+
+	// Check invalid Lines
+	if _, err := parseCredentials(strings.NewReader("[x]\nhost")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected the invalid line to be reported, got: %v", err)
+	}
+}
+
+/**
+ * Test Method for Profile Loading and Settings Resolution
+ */
+func TestLoadProfile(t *testing.T) {
+
+	// Write Credentials and Password Files
+	dir := testCredentialsFile(t, "[branch-1]\nhost = 10.1.0.1\nusername = terraform\npassword_file = "+filepath.Join(t.TempDir(), "missing")+"\n[branch-2]\nhost = 10.2.0.1\n[relative]\npassword_file = password\n")
+	passwordFile := filepath.Join(dir, "password")
+	if err := ioutil.WriteFile(passwordFile, []byte("s3cret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Check named Profile
+	profile, err := LoadProfile("branch-2")
+	if err != nil || profile.Get("host") != "10.2.0.1" {
+		t.Errorf("The profile does not match what we expected. actual: %+v (%v)", profile, err)
+	}
+
+	// Check unreadable Password File is reported
+	profile, _ = LoadProfile("branch-1")
+	if _, err := profile.Password(); err == nil || !strings.HasPrefix(err.Error(), "password_file:") {
+		t.Errorf("Expected the missing password file to be reported, got: %v", err)
+	}
+
+	// Check relative Password File is read next to the Credentials File
+	profile, _ = LoadProfile("relative")
+	if password, err := profile.Password(); err != nil || password != "s3cret" {
+		t.Errorf("Expected the password file next to the credentials file to be read, got: %s (%v)", password, err)
+	}
+
+	// Check missing Profiles (the default one may be missing)
+	if _, err := LoadProfile("branch-3"); err == nil {
+		t.Errorf("Expected the missing profile to be reported")
+	}
+	if profile, err := LoadProfile(""); err != nil || profile.Get("host") != "" {
+		t.Errorf("Expected an empty default profile, got: %+v (%v)", profile, err)
+	}
+
+	// Check Environment Variables override the Profile
+	for _, key := range []string{"MIKROTIK_HOST", "MIKROTIK_USER", "MIKROTIK_PASSWORD", "MIKROTIK_TLS", "MIKROTIK_CA_CERTIFICATE", "MIKROTIK_INSECURE"} {
+		unsetenv(t, key)
+	}
+	t.Setenv("MIKROTIK_PROFILE", "branch-1")
+	t.Setenv("MIKROTIK_USER", "admin")
+	t.Setenv("MIKROTIK_PASSWORD_FILE", passwordFile)
+	host, username, password, _, _, _ := GetConfigFromEnv()
+	if host != "10.1.0.1" || username != "admin" || password != "s3cret" {
+		t.Errorf("The configuration does not match what we expected. actual: %s %s %s", host, username, password)
+	}
+
+	// Check relative Password File of the Profile is read next to the Credentials File
+	unsetenv(t, "MIKROTIK_PASSWORD_FILE")
+	t.Setenv("MIKROTIK_PROFILE", "relative")
+	if _, _, password, _, _, _, err := LoadConfigFromEnv(); err != nil || password != "s3cret" {
+		t.Errorf("Expected the password file of the profile to be read next to the credentials file, got: %s (%v)", password, err)
+	}
+
+	// Check misspelled Profile is reported instead of giving empty Settings
+	t.Setenv("MIKROTIK_PROFILE", "branch-3")
+	if _, _, _, _, _, _, err := LoadConfigFromEnv(); err == nil || !strings.Contains(err.Error(), "branch-3") {
+		t.Errorf("Expected the missing profile to be reported, got: %v", err)
+	}
+	unsetenv(t, "LEGACY_BGP_SUPPORT")
+	if _, err := Setting("LEGACY_BGP_SUPPORT", "legacy_bgp_support"); err == nil {
+		t.Errorf("Expected the missing profile to be reported by the setting")
+	}
+}
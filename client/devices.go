@@ -0,0 +1,148 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/**
+ * Define Devices Structure: the Clients of every Router managed by one Provider Configuration, keyed on
+ * the Device Name. Each Client keeps its own Connection, Cache and Connectivity Guard. The Router
+ * configured without a Name is the default Device.
+ */
+type Devices struct {
+	mutex   sync.RWMutex
+	clients map[string]*Mikrotik
+}
+
+/**
+ * Function used to build an empty Device Registry
+ */
+func NewDevices() *Devices {
+
+	// Build and Return Devices
+	return &Devices{clients: map[string]*Mikrotik{}}
+}
+
+/**
+ * Function used to Register the Client of a Device ("" for the default Device)
+ */
+func (devices *Devices) Add(name string, client *Mikrotik) error {
+
+	// Lock Registry
+	devices.mutex.Lock()
+	defer devices.mutex.Unlock()
+
+	// If Device is already registered
+	if _, ok := devices.clients[name]; ok {
+
+		// Return Error
+		return fmt.Errorf("device `%s` is configured more than once", name)
+	}
+
+	// Register Client
+	devices.clients[name] = client
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Get the Client of a Device ("" for the default Device)
+ */
+func (devices *Devices) Get(name string) (*Mikrotik, error) {
+
+	// Lock Registry
+	devices.mutex.RLock()
+	defer devices.mutex.RUnlock()
+
+	// Find Client
+	client, ok := devices.clients[name]
+
+	// If Device is the missing default Device
+	if !ok && name == "" {
+
+		// Return Error
+		return nil, fmt.Errorf("no default device: set the provider `host` or the `device` attribute")
+	}
+
+	// If Device is unknown
+	if !ok {
+
+		// Return Error
+		return nil, fmt.Errorf("unknown device `%s`, known devices are %v", name, devices.names())
+	}
+
+	// Return Client
+	return client, nil
+}
+
+/**
+ * Function used to get the sorted Names of the named Devices
+ */
+func (devices *Devices) Names() []string {
+
+	// Lock Registry
+	devices.mutex.RLock()
+	defer devices.mutex.RUnlock()
+
+	// Return Names
+	return devices.names()
+}
+
+/**
+ * Function used to collect the sorted Names of the named Devices (the Registry must be locked)
+ */
+func (devices *Devices) names() []string {
+
+	// Collect Names
+	names := []string{}
+	for name := range devices.clients {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	// Sort Names
+	sort.Strings(names)
+
+	// Return Names
+	return names
+}
+
+/**
+ * Function used to Remove the Connectivity Guard Backup of every Device once the Apply is done. Each Device
+ * is cleaned up even when another one cannot be reached.
+ */
+func (devices *Devices) RemoveConnectivityGuards() error {
+
+	// Lock Registry
+	devices.mutex.RLock()
+	defer devices.mutex.RUnlock()
+
+	// Clean each Device up
+	errs := []string{}
+	for name, client := range devices.clients {
+		if err := client.RemoveConnectivityGuard(); err != nil {
+			if name == "" {
+				errs = append(errs, err.Error())
+			} else {
+				errs = append(errs, fmt.Sprintf("device `%s`: %v", name, err))
+			}
+		}
+	}
+
+	// If a Device could not be cleaned up
+	if len(errs) > 0 {
+
+		// Return Error
+		sort.Strings(errs)
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	// Return no Error
+	return nil
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// DhcpClient is a DHCP client leasing the address of an interface, usually the WAN facing one.
+// Address, Gateway and Status are read-only, reported once the client got a lease.
+type DhcpClient struct {
+	Id                   string `mikrotik:".id"`
+	Interface            string `mikrotik:"interface"`
+	AddDefaultRoute      string `mikrotik:"add-default-route"`
+	DefaultRouteDistance int    `mikrotik:"default-route-distance"`
+	UsePeerDns           bool   `mikrotik:"use-peer-dns"`
+	UsePeerNtp           bool   `mikrotik:"use-peer-ntp"`
+	Script               string `mikrotik:"script"`
+	Disabled             bool   `mikrotik:"disabled"`
+	Comment              string `mikrotik:"comment"`
+
+	Address string
+	Gateway string
+	Status  string
+}
+
+func (client Mikrotik) AddDhcpClient(d *DhcpClient) (*DhcpClient, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-client/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpClient(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindDhcpClient(id string) (*DhcpClient, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-client/print", proplist(DhcpClient{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp client: %v", r)
+
+	record := DhcpClient{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("dhcp client `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateDhcpClient(d *DhcpClient) (*DhcpClient, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-client/set", d)
+	if d.Script == "" {
+		cmd = append(cmd, "=script=")
+	}
+	if d.Comment == "" {
+		cmd = append(cmd, "=comment=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpClient(d.Id)
+}
+
+func (client Mikrotik) DeleteDhcpClient(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ip/dhcp-client/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListDhcpClients() ([]DhcpClient, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-client/print", proplist(DhcpClient{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp clients: %v", r)
+
+	records := []DhcpClient{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,52 @@
+package client
+
+import "testing"
+
+func TestAddDhcpClientUpdateAndDelete(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	bridge, err := c.AddBridgeInterface(&BridgeInterface{Name: "terraform-dhcp-client"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteBridgeInterface(bridge.Id)
+
+	dhcpClient, err := c.AddDhcpClient(&DhcpClient{
+		Interface:       bridge.Name,
+		AddDefaultRoute: "no",
+		UsePeerDns:      false,
+		UsePeerNtp:      false,
+		Script:          ":put lease",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dhcpClient.Status == "" {
+		t.Error("expected the status to be reported")
+	}
+
+	dhcpClient.Script = ""
+	dhcpClient.DefaultRouteDistance = 5
+	updated, err := c.UpdateDhcpClient(dhcpClient)
+	if err != nil {
+		t.Error(err)
+	} else {
+		if updated.Script != "" {
+			t.Errorf("expected script to be cleared, got %q", updated.Script)
+		}
+		if updated.DefaultRouteDistance != 5 {
+			t.Errorf("expected default route distance to be %d, got %d", 5, updated.DefaultRouteDistance)
+		}
+	}
+
+	// cleanup
+	if err := c.DeleteDhcpClient(dhcpClient.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindDhcpClient(dhcpClient.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"log"
+)
+
+/**
+ * Define DHCP Lease Table Entry Structure: the static Lease of a Host on a DHCP Server, managed along with
+ * the other Leases of that Server
+ */
+type DhcpLeaseTableEntry struct {
+	Id          string `mikrotik:".id"`
+	Server      string `mikrotik:"server"`
+	Address     string `mikrotik:"address"`
+	MacAddress  string `mikrotik:"mac-address"`
+	Comment     string `mikrotik:"comment"`
+	BlockAccess bool   `mikrotik:"block-access"`
+	Dynamic     bool   `mikrotik:"dynamic,readonly"`
+}
+
+/**
+ * Function used to List the static Leases of a DHCP Server from Mikrotik Router
+ */
+func (client Mikrotik) ListDhcpLeaseTable(server string) ([]DhcpLeaseTableEntry, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running List DHCP Lease Table `%s`", server)
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := []string{"/ip/dhcp-server/lease/print", proplist(DhcpLeaseTableEntry{}), "?server=" + server}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	r, err := client.runArgs(c, cmd)
+
+	// Log Command execution result
+	log.Printf("[INFO] DHCP Lease Table response: `%v`", r)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Instantiate Entry Array
+	entries := []DhcpLeaseTableEntry{}
+
+	// Unmarshall Response
+	err = Unmarshal(*r, &entries)
+
+	// If There is Error (Unmarshalling)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Keep static Leases (dynamic ones are given by the server)
+	static := []DhcpLeaseTableEntry{}
+	for _, entry := range entries {
+		if !entry.Dynamic {
+			static = append(static, entry)
+		}
+	}
+
+	// Return result
+	return static, nil
+}
+
+/**
+ * Function used to ADD many DHCP Lease Table Entries on Mikrotik Router in one Batch
+ */
+func (client Mikrotik) AddDhcpLeaseTable(entries []DhcpLeaseTableEntry) ([]DhcpLeaseTableEntry, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running ADD of %d DHCP Lease Table Entries", len(entries))
+
+	// Generate Mikrotik Commands
+	cmds := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		cmds = append(cmds, Marshal("/ip/dhcp-server/lease/add", entry))
+	}
+
+	// Run Batch
+	ids, err := client.batchAdd(cmds)
+
+	// Set the IDs of the added Entries (also when others failed)
+	added := []DhcpLeaseTableEntry{}
+	for i, id := range ids {
+		if id != "" {
+			entry := entries[i]
+			entry.Id = id
+			added = append(added, entry)
+		}
+	}
+
+	// Return added Entries and Error
+	return added, err
+}
+
+/**
+ * Function used to UPDATE many DHCP Lease Table Entries on Mikrotik Router in one Batch
+ */
+func (client Mikrotik) UpdateDhcpLeaseTable(entries []DhcpLeaseTableEntry) error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running UPDATE of %d DHCP Lease Table Entries", len(entries))
+
+	// Generate Mikrotik Commands
+	cmds := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		cmd := Marshal("/ip/dhcp-server/lease/set", entry)
+
+		// Clear removed Comment (Marshal skips empty Values)
+		if entry.Comment == "" {
+			cmd = append(cmd, "=comment=")
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	// Run Batch
+	_, err := client.RunBatch(cmds)
+
+	// Return Error
+	return err
+}
+
+/**
+ * Function used to DELETE many DHCP Lease Table Entries from Mikrotik Router in one Batch
+ */
+func (client Mikrotik) DeleteDhcpLeaseTable(ids []string) error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running DELETE of %d DHCP Lease Table Entries", len(ids))
+
+	// Remove Entries in one Batch
+	return client.batchRemove("/ip/dhcp-server/lease", ids)
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"testing"
+)
+
+/**
+ * Test Method for DHCP Lease Table Batch ADD, UPDATE and DELETE Operations
+ */
+func TestDhcpLeaseTableBatchOperations(t *testing.T) {
+
+	// Build Client of a Fake Router
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "router", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	// Add a dynamic Lease, which is not part of the Table
+	menus.handle([]string{"/ip/dhcp-server/lease/add", "=server=lan", "=address=10.0.0.200", "=mac-address=AA:BB:CC:DD:EE:00", "=dynamic=true"})
+
+	// Add Leases
+	added, err := c.AddDhcpLeaseTable([]DhcpLeaseTableEntry{
+		{Server: "lan", Address: "10.0.0.10", MacAddress: "AA:BB:CC:DD:EE:01", Comment: "printer"},
+		{Server: "lan", Address: "10.0.0.11", MacAddress: "AA:BB:CC:DD:EE:02", BlockAccess: true},
+	})
+	if err != nil || len(added) != 2 {
+		t.Fatalf("Error Adding DHCP Lease Table with: %v (%v)", err, added)
+	}
+
+	// Update the first Lease (removing its Comment) and Delete the second one
+	added[0].Address, added[0].Comment = "10.0.0.12", ""
+	if err := c.UpdateDhcpLeaseTable(added[:1]); err != nil {
+		t.Fatalf("Error Updating DHCP Lease Table with: %v", err)
+	}
+	if err := c.DeleteDhcpLeaseTable([]string{added[1].Id, "*FF"}); err != nil {
+		t.Fatalf("Error Deleting DHCP Lease Table with: %v", err)
+	}
+
+	// Check the static Leases left on the Router
+	found, err := c.ListDhcpLeaseTable("lan")
+	if err != nil {
+		t.Fatalf("Error Listing DHCP Lease Table with: %v", err)
+	}
+	if len(found) != 1 || found[0].Address != "10.0.0.12" || found[0].Comment != "" || found[0].Id != added[0].Id {
+		t.Errorf("The DHCP lease table does not match what we expected. actual: %+v", found)
+	}
+}
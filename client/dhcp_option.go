@@ -0,0 +1,223 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// DhcpOption is a DHCP option handed out by DHCP servers through option sets or networks.
+// Value is a RouterOS option expression, RawValue the bytes it encodes to (read-only).
+type DhcpOption struct {
+	Id       string `mikrotik:".id"`
+	Name     string `mikrotik:"name"`
+	Code     int    `mikrotik:"code"`
+	Value    string `mikrotik:"value"`
+	RawValue string `mikrotik:"raw-value,readonly"`
+	Comment  string `mikrotik:"comment"`
+}
+
+// DhcpOptionSet groups DHCP options under one name, Options being comma separated option names
+type DhcpOptionSet struct {
+	Id      string `mikrotik:".id"`
+	Name    string `mikrotik:"name"`
+	Options string `mikrotik:"options"`
+	Comment string `mikrotik:"comment"`
+}
+
+func (client Mikrotik) AddDhcpOption(d *DhcpOption) (*DhcpOption, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-server/option/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpOption(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindDhcpOption(id string) (*DhcpOption, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-server/option/print", proplist(DhcpOption{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp option: %v", r)
+
+	record := DhcpOption{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("dhcp option `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateDhcpOption(d *DhcpOption) (*DhcpOption, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-server/option/set", d)
+	if d.Comment == "" {
+		cmd = append(cmd, "=comment=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpOption(d.Id)
+}
+
+func (client Mikrotik) DeleteDhcpOption(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ip/dhcp-server/option/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListDhcpOptions() ([]DhcpOption, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-server/option/print", proplist(DhcpOption{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp options: %v", r)
+
+	records := []DhcpOption{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (client Mikrotik) AddDhcpOptionSet(d *DhcpOptionSet) (*DhcpOptionSet, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-server/option/sets/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpOptionSet(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindDhcpOptionSet(id string) (*DhcpOptionSet, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-server/option/sets/print", proplist(DhcpOptionSet{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp option set: %v", r)
+
+	record := DhcpOptionSet{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("dhcp option set `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateDhcpOptionSet(d *DhcpOptionSet) (*DhcpOptionSet, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-server/option/sets/set", d)
+	if d.Comment == "" {
+		cmd = append(cmd, "=comment=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpOptionSet(d.Id)
+}
+
+func (client Mikrotik) DeleteDhcpOptionSet(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ip/dhcp-server/option/sets/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListDhcpOptionSets() ([]DhcpOptionSet, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-server/option/sets/print", proplist(DhcpOptionSet{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp option sets: %v", r)
+
+	records := []DhcpOptionSet{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import "testing"
+
+func TestAddDhcpOptionAndSetUpdateAndDelete(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	option, err := c.AddDhcpOption(&DhcpOption{
+		Name:  "terraform-tftp-server",
+		Code:  66,
+		Value: "'10.0.0.1'",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteDhcpOption(option.Id)
+
+	if option.RawValue != "0a000001" {
+		t.Errorf("expected raw value to be %q, got %q", "0a000001", option.RawValue)
+	}
+
+	set, err := c.AddDhcpOptionSet(&DhcpOptionSet{
+		Name:    "terraform-pxe",
+		Options: option.Name,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	option.Value = "s'tftp.lan'"
+	updated, err := c.UpdateDhcpOption(option)
+	if err != nil {
+		t.Error(err)
+	} else if updated.Value != "s'tftp.lan'" {
+		t.Errorf("expected value to be %q, got %q", "s'tftp.lan'", updated.Value)
+	}
+
+	// cleanup
+	if err := c.DeleteDhcpOptionSet(set.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindDhcpOptionSet(set.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestUpdateDhcpOptionAndSetClearComment(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	option, err := c.AddDhcpOption(&DhcpOption{Name: "tftp-server", Code: 66, Value: "'10.0.0.1'", Comment: "pxe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	option.Comment = ""
+	if option, err = c.UpdateDhcpOption(option); err != nil || option.Comment != "" {
+		t.Errorf("expected the option comment to be cleared, got %+v, %v", option, err)
+	}
+
+	set, err := c.AddDhcpOptionSet(&DhcpOptionSet{Name: "pxe", Options: "tftp-server", Comment: "pxe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	set.Comment = ""
+	if set, err = c.UpdateDhcpOptionSet(set); err != nil || set.Comment != "" {
+		t.Errorf("expected the option set comment to be cleared, got %+v, %v", set, err)
+	}
+}
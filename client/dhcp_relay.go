@@ -0,0 +1,122 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// DhcpRelay forwards the DHCP requests received on an interface to DHCP servers of another network.
+// DhcpServer holds the comma separated addresses of the servers. An empty LocalAddress or DelayThreshold
+// is updated to the RouterOS default (0.0.0.0 and none).
+type DhcpRelay struct {
+	Id             string `mikrotik:".id"`
+	Name           string `mikrotik:"name"`
+	Interface      string `mikrotik:"interface"`
+	DhcpServer     string `mikrotik:"dhcp-server"`
+	LocalAddress   string `mikrotik:"local-address"`
+	DelayThreshold string `mikrotik:"delay-threshold"`
+	AddRelayInfo   bool   `mikrotik:"add-relay-info"`
+	Disabled       bool   `mikrotik:"disabled"`
+}
+
+func (client Mikrotik) AddDhcpRelay(d *DhcpRelay) (*DhcpRelay, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-relay/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpRelay(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindDhcpRelay(id string) (*DhcpRelay, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-relay/print", proplist(DhcpRelay{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp relay: %v", r)
+
+	record := DhcpRelay{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("dhcp relay `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateDhcpRelay(d *DhcpRelay) (*DhcpRelay, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/dhcp-relay/set", d)
+	if d.LocalAddress == "" {
+		cmd = append(cmd, "=local-address=0.0.0.0")
+	}
+	if d.DelayThreshold == "" {
+		cmd = append(cmd, "=delay-threshold=none")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpRelay(d.Id)
+}
+
+func (client Mikrotik) DeleteDhcpRelay(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ip/dhcp-relay/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListDhcpRelays() ([]DhcpRelay, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-relay/print", proplist(DhcpRelay{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp relays: %v", r)
+
+	records := []DhcpRelay{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import "testing"
+
+func TestAddDhcpRelayUpdateAndDelete(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	bridge, err := c.AddBridgeInterface(&BridgeInterface{Name: "terraform-dhcp-relay"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteBridgeInterface(bridge.Id)
+
+	relay, err := c.AddDhcpRelay(&DhcpRelay{
+		Name:       "terraform-relay",
+		Interface:  bridge.Name,
+		DhcpServer: "10.0.0.1",
+		Disabled:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relay.DhcpServer = "10.0.0.1,10.0.0.2"
+	updated, err := c.UpdateDhcpRelay(relay)
+	if err != nil {
+		t.Error(err)
+	} else if updated.DhcpServer != relay.DhcpServer {
+		t.Errorf("expected dhcp servers to be %q, got %q", relay.DhcpServer, updated.DhcpServer)
+	}
+
+	// cleanup
+	if err := c.DeleteDhcpRelay(relay.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindDhcpRelay(relay.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestUpdateDhcpRelayResetsLocalAddressAndDelayThreshold(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	relay, err := c.AddDhcpRelay(&DhcpRelay{
+		Name:           "relay",
+		Interface:      "ether2",
+		DhcpServer:     "10.0.0.1",
+		LocalAddress:   "192.168.88.1",
+		DelayThreshold: "5s",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relay.LocalAddress, relay.DelayThreshold = "", ""
+	relay, err = c.UpdateDhcpRelay(relay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if relay.LocalAddress != "0.0.0.0" || relay.DelayThreshold != "none" {
+		t.Errorf("expected the local address and delay threshold to be reset, got %q and %q", relay.LocalAddress, relay.DelayThreshold)
+	}
+}
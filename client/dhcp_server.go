@@ -15,6 +15,16 @@ type DhcpServer struct {
 	Authoritative string `mikrotik:"authoritative"`
 	Interface     string `mikrotik:"interface"`
 	LeaseScript   string `mikrotik:"lease-script"`
+
+	LeaseTime         string `mikrotik:"lease-time"`
+	Relay             string `mikrotik:"relay"`
+	ConflictDetection bool   `mikrotik:"conflict-detection"`
+	UseRadius         string `mikrotik:"use-radius"`
+	AlwaysBroadcast   bool   `mikrotik:"always-broadcast"`
+	BootpSupport      string `mikrotik:"bootp-support"`
+	AddressLists      string `mikrotik:"address-lists"`
+	DelayThreshold    string `mikrotik:"delay-threshold"`
+	Dynamic           bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddDhcpServer(d *DhcpServer) (*DhcpServer, error) {
@@ -25,13 +35,13 @@ func (client Mikrotik) AddDhcpServer(d *DhcpServer) (*DhcpServer, error) {
 
 	cmd := Marshal("/ip/dhcp-server/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[DEBUG] command returned: %v", r)
 
-	return client.FindDhcpServer(d.Name)
+	return client.FindDhcpServer(r.Done.Map["ret"])
 }
 
 func (client Mikrotik) UpdateDhcpServer(d *DhcpServer) (*DhcpServer, error) {
@@ -41,25 +51,39 @@ func (client Mikrotik) UpdateDhcpServer(d *DhcpServer) (*DhcpServer, error) {
 	}
 
 	cmd := Marshal("/ip/dhcp-server/set", d)
+	if d.AddressLists == "" {
+		cmd = append(cmd, "=address-lists=")
+	}
+	if d.LeaseScript == "" {
+		cmd = append(cmd, "=lease-script=")
+	}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[DEBUG] command returned: %v", r)
 
-	return client.FindDhcpServer(d.Name)
+	return client.FindDhcpServer(d.Id)
+}
+
+func (client Mikrotik) FindDhcpServer(id string) (*DhcpServer, error) {
+	return client.findDhcpServer("?.id=", id)
+}
+
+func (client Mikrotik) FindDhcpServerByName(name string) (*DhcpServer, error) {
+	return client.findDhcpServer("?name=", name)
 }
 
-func (client Mikrotik) FindDhcpServer(name string) (*DhcpServer, error) {
+func (client Mikrotik) findDhcpServer(query, value string) (*DhcpServer, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/dhcp-server/print", "?name=" + name}
+	cmd := []string{"/ip/dhcp-server/print", proplist(DhcpServer{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -74,21 +98,21 @@ func (client Mikrotik) FindDhcpServer(name string) (*DhcpServer, error) {
 	}
 
 	if record.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("dhcp server `%s` not found", name))
+		return nil, NewNotFound(fmt.Sprintf("dhcp server `%s` not found", value))
 	}
 
 	return &record, nil
 }
 
-func (client Mikrotik) DeleteDhcpServer(name string) error {
+func (client Mikrotik) DeleteDhcpServer(id string) error {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return err
 	}
 
-	cmd := []string{"/ip/dhcp-server/remove", "=numbers=" + name}
+	cmd := []string{"/ip/dhcp-server/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return err
 	}
@@ -96,3 +120,26 @@ func (client Mikrotik) DeleteDhcpServer(name string) error {
 
 	return nil
 }
+
+func (client Mikrotik) ListDhcpServers() ([]DhcpServer, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-server/print", proplist(DhcpServer{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp servers: %v", r)
+
+	records := []DhcpServer{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -19,6 +19,7 @@ type DhcpServerNetwork struct {
 	BootFileName  string `mikrotik:"boot-file-name"`
 	Domain        string `mikrotik:"domain"`
 	DhcpOptionSet string `mikrotik:"dhcp-option-set"`
+	Dynamic       bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddDhcpServerNetwork(d *DhcpServerNetwork) (*DhcpServerNetwork, error) {
@@ -29,7 +30,7 @@ func (client Mikrotik) AddDhcpServerNetwork(d *DhcpServerNetwork) (*DhcpServerNe
 
 	cmd := Marshal("/ip/dhcp-server/network/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -45,9 +46,9 @@ func (client Mikrotik) FindDhcpServerNetwork(id string) (*DhcpServerNetwork, err
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/dhcp-server/network/print", "?.id=" + id}
+	cmd := []string{"/ip/dhcp-server/network/print", proplist(DhcpServerNetwork{}), "?.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -75,7 +76,7 @@ func (client Mikrotik) UpdateDhcpServerNetwork(d *DhcpServerNetwork) (*DhcpServe
 
 	cmd := Marshal("/ip/dhcp-server/network/set", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +93,7 @@ func (client Mikrotik) DeleteDhcpServerNetwork(id string) error {
 
 	cmd := []string{"/ip/dhcp-server/network/remove", "=.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return err
 	}
@@ -100,3 +101,26 @@ func (client Mikrotik) DeleteDhcpServerNetwork(id string) error {
 
 	return nil
 }
+
+func (client Mikrotik) ListDhcpServerNetworks() ([]DhcpServerNetwork, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dhcp-server/network/print", proplist(DhcpServerNetwork{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcp server networks: %v", r)
+
+	records := []DhcpServerNetwork{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
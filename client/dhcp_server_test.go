@@ -15,7 +15,7 @@ func TestAddDhcpServerUpdateAndDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foundServer, err := c.FindDhcpServer(name)
+	foundServer, err := c.FindDhcpServerByName(name)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -39,8 +39,33 @@ func TestAddDhcpServerUpdateAndDelete(t *testing.T) {
 		t.Error(err)
 	}
 
-	_, err = c.FindDhcpServer(name)
+	_, err = c.FindDhcpServerByName(name)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
 }
+
+func TestUpdateDhcpServerClearsListsAndScript(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	dhcpServer, err := c.AddDhcpServer(&DhcpServer{
+		Name:         "myserver",
+		Interface:    "ether2",
+		AddressLists: "dhcp-clients",
+		LeaseScript:  ":log info lease",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dhcpServer.AddressLists = ""
+	dhcpServer.LeaseScript = ""
+	dhcpServer, err = c.UpdateDhcpServer(dhcpServer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dhcpServer.AddressLists != "" || dhcpServer.LeaseScript != "" {
+		t.Errorf("The address lists and lease script were not cleared. actual: %+v", dhcpServer)
+	}
+}
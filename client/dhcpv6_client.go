@@ -0,0 +1,122 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// Dhcpv6Client is a DHCPv6 client requesting an address and/or a delegated prefix, the prefix being added to PoolName.
+// Request holds the comma separated requests (`address`, `prefix`). Prefix (with its expiry) and Status are read-only.
+type Dhcpv6Client struct {
+	Id               string `mikrotik:".id"`
+	Interface        string `mikrotik:"interface"`
+	Request          string `mikrotik:"request"`
+	PoolName         string `mikrotik:"pool-name"`
+	PoolPrefixLength int    `mikrotik:"pool-prefix-length"`
+	PrefixHint       string `mikrotik:"prefix-hint"`
+	AddDefaultRoute  bool   `mikrotik:"add-default-route"`
+	UsePeerDns       bool   `mikrotik:"use-peer-dns"`
+	Disabled         bool   `mikrotik:"disabled"`
+	Comment          string `mikrotik:"comment"`
+	Prefix           string `mikrotik:"prefix,readonly"`
+	Status           string `mikrotik:"status,readonly"`
+}
+
+func (client Mikrotik) AddDhcpv6Client(d *Dhcpv6Client) (*Dhcpv6Client, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/dhcp-client/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpv6Client(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindDhcpv6Client(id string) (*Dhcpv6Client, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/dhcp-client/print", proplist(Dhcpv6Client{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcpv6 client: %v", r)
+
+	record := Dhcpv6Client{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("dhcpv6 client `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateDhcpv6Client(d *Dhcpv6Client) (*Dhcpv6Client, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/dhcp-client/set", d)
+	if d.Comment == "" {
+		cmd = append(cmd, "=comment=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpv6Client(d.Id)
+}
+
+func (client Mikrotik) DeleteDhcpv6Client(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ipv6/dhcp-client/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListDhcpv6Clients() ([]Dhcpv6Client, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/dhcp-client/print", proplist(Dhcpv6Client{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcpv6 clients: %v", r)
+
+	records := []Dhcpv6Client{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
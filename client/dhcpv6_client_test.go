@@ -0,0 +1,45 @@
+package client
+
+import "testing"
+
+func TestAddDhcpv6ClientUpdateAndDelete(t *testing.T) {
+	if IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	c := NewClient(GetConfigFromEnv())
+
+	bridge, err := c.AddBridgeInterface(&BridgeInterface{Name: "terraform-dhcpv6-client"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteBridgeInterface(bridge.Id)
+
+	dhcpClient, err := c.AddDhcpv6Client(&Dhcpv6Client{
+		Interface: bridge.Name,
+		Request:   "prefix",
+		PoolName:  "terraform-delegated",
+		Disabled:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dhcpClient.PoolPrefixLength = 60
+	updated, err := c.UpdateDhcpv6Client(dhcpClient)
+	if err != nil {
+		t.Error(err)
+	} else if updated.PoolPrefixLength != 60 {
+		t.Errorf("expected pool prefix length to be %d, got %d", 60, updated.PoolPrefixLength)
+	}
+
+	// cleanup
+	if err := c.DeleteDhcpv6Client(dhcpClient.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindDhcpv6Client(dhcpClient.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// Dhcpv6Server delegates prefixes of AddressPool to the clients of an interface.
+// DhcpOption holds the comma separated names of the DHCPv6 options handed out.
+type Dhcpv6Server struct {
+	Id          string `mikrotik:".id"`
+	Name        string `mikrotik:"name"`
+	Interface   string `mikrotik:"interface"`
+	AddressPool string `mikrotik:"address-pool"`
+	LeaseTime   string `mikrotik:"lease-time"`
+	Preference  int    `mikrotik:"preference"`
+	RapidCommit bool   `mikrotik:"rapid-commit"`
+	DhcpOption  string `mikrotik:"dhcp-option"`
+	Disabled    bool   `mikrotik:"disabled"`
+}
+
+func (client Mikrotik) AddDhcpv6Server(d *Dhcpv6Server) (*Dhcpv6Server, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/dhcp-server/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpv6Server(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindDhcpv6Server(id string) (*Dhcpv6Server, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/dhcp-server/print", proplist(Dhcpv6Server{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcpv6 server: %v", r)
+
+	record := Dhcpv6Server{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("dhcpv6 server `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateDhcpv6Server(d *Dhcpv6Server) (*Dhcpv6Server, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/dhcp-server/set", d)
+	if d.DhcpOption == "" {
+		cmd = append(cmd, "=dhcp-option=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindDhcpv6Server(d.Id)
+}
+
+func (client Mikrotik) DeleteDhcpv6Server(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ipv6/dhcp-server/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListDhcpv6Servers() ([]Dhcpv6Server, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/dhcp-server/print", proplist(Dhcpv6Server{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dhcpv6 servers: %v", r)
+
+	records := []Dhcpv6Server{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
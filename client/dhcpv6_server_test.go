@@ -0,0 +1,51 @@
+package client
+
+import "testing"
+
+func TestAddDhcpv6ServerUpdateAndDelete(t *testing.T) {
+	if IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	c := NewClient(GetConfigFromEnv())
+
+	bridge, err := c.AddBridgeInterface(&BridgeInterface{Name: "terraform-dhcpv6-server"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteBridgeInterface(bridge.Id)
+
+	pool, err := c.AddIpv6Pool(&Ipv6Pool{Name: "terraform-dhcpv6-server", Prefix: "2001:db8:200::/48", PrefixLength: 56})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteIpv6Pool(pool.Id)
+
+	server, err := c.AddDhcpv6Server(&Dhcpv6Server{
+		Name:        "terraform-dhcpv6-server",
+		Interface:   bridge.Name,
+		AddressPool: pool.Name,
+		Disabled:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.RapidCommit = false
+	updated, err := c.UpdateDhcpv6Server(server)
+	if err != nil {
+		t.Error(err)
+	} else if updated.RapidCommit {
+		t.Error("expected rapid commit to be disabled")
+	}
+
+	// cleanup
+	if err := c.DeleteDhcpv6Server(server.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindDhcpv6Server(server.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
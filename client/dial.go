@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-routeros/routeros"
+)
+
+// Default API Ports of RouterOS (`api` and `api-ssl` services)
+const (
+	DefaultApiPort    = 8728
+	DefaultApiSslPort = 8729
+)
+
+/**
+ * Define Dialer Interface: opens the Network Connection to a Router (*net.Dialer implements it, and
+ * tests plug a fake Router in)
+ */
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+/**
+ * Function used to get the Address of the Router API: the Host (a name, an IPv4 Address or an IPv6
+ * Address, bracketed or not) with the Port, defaulting to the API or API-SSL Port
+ */
+func (client *Mikrotik) Address() (string, error) {
+
+	// Split Host and Port
+	host, port, err := net.SplitHostPort(client.Host)
+
+	// If Host carries no Port (a name, an IPv4 or a bare or bracketed IPv6 Address)
+	if err != nil {
+		host, port = strings.TrimSuffix(strings.TrimPrefix(client.Host, "["), "]"), ""
+	}
+
+	// If Host is Empty
+	if host == "" {
+
+		// Return Error
+		return "", fmt.Errorf("no host configured")
+	}
+
+	// If Port is set
+	if client.Port != 0 {
+
+		// If Host carries another Port
+		if port != "" && port != strconv.Itoa(client.Port) {
+
+			// Return Error
+			return "", fmt.Errorf("host `%s` and port %d disagree, set the port once", client.Host, client.Port)
+		}
+
+		// Use Port
+		port = strconv.Itoa(client.Port)
+	}
+
+	// If Port is still unknown
+	if port == "" {
+
+		// Default Port by Service
+		port = strconv.Itoa(DefaultApiPort)
+		if client.TLS {
+			port = strconv.Itoa(DefaultApiSslPort)
+		}
+	}
+
+	// Return Address
+	return net.JoinHostPort(host, port), nil
+}
+
+/**
+ * Function used to get the Dialer of the Client: the configured one, or a Network Dialer built from
+ * the Connect Timeout, Keepalive and Bind Address
+ */
+func (client *Mikrotik) dialer() (Dialer, error) {
+
+	// If a Dialer is configured
+	if client.Dialer != nil {
+		return client.Dialer, nil
+	}
+
+	// Build Network Dialer
+	dialer := &net.Dialer{Timeout: client.ConnectTimeout, KeepAlive: client.KeepAlive}
+
+	// If a Bind Address is set
+	if client.BindAddress != "" {
+
+		// Parse Bind Address
+		ip := net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(client.BindAddress, "["), "]"))
+
+		// If Bind Address is not an IP Address
+		if ip == nil {
+
+			// Return Error
+			return nil, fmt.Errorf("bind_address: `%s` is not an IP address", client.BindAddress)
+		}
+
+		// Bind Source Address
+		dialer.LocalAddr = &net.TCPAddr{IP: ip}
+	}
+
+	// Return Dialer
+	return dialer, nil
+}
+
+/**
+ * Function used to Open a Connection to the Router and Log in: the Connect Timeout bounds the
+ * Connection, the TLS Handshake and the Login
+ */
+func (client *Mikrotik) dial() (*routeros.Client, error) {
+
+	// Get Address
+	address, err := client.Address()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Dialer
+	dialer, err := client.dialer()
+	if err != nil {
+		return nil, err
+	}
+
+	// Bound Connection by the Connect Timeout
+	ctx := context.Background()
+	deadline := time.Time{}
+	if client.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		deadline = time.Now().Add(client.ConnectTimeout)
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+
+	// Open Connection
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bound Handshake and Login by the Connect Timeout too
+	conn.SetDeadline(deadline)
+
+	// If Connection uses TLS
+	if client.TLS {
+
+		// Build TLS Configuration
+		config, err := client.TLSConfig()
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		// Verify the Certificate against the Host when no Server Name is set
+		if config.ServerName == "" {
+			config.ServerName, _, _ = net.SplitHostPort(address)
+		}
+
+		// Run Handshake
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	// Log in
+	mikrotikClient, err := routeros.NewClient(conn)
+	if err == nil {
+		err = mikrotikClient.Login(client.Username, client.Password)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Failed to login to routerOS with error: %v", err)
+		conn.Close()
+		return nil, err
+	}
+
+	// Lift Deadline (Commands may run longer than the Connect Timeout)
+	conn.SetDeadline(time.Time{})
+
+	// Return Client
+	return mikrotikClient, nil
+}
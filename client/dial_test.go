@@ -0,0 +1,120 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+/**
+ * Test Method for API Addresses built from Host, Port and TLS
+ */
+func TestAddress(t *testing.T) {
+
+	// Define Cases
+	cases := []struct {
+		host     string
+		port     int
+		tls      bool
+		expected string
+	}{
+		{host: "router.lan", expected: "router.lan:8728"},
+		{host: "router.lan", tls: true, expected: "router.lan:8729"},
+		{host: "router.lan:9000", expected: "router.lan:9000"},
+		{host: "router.lan", port: 9000, tls: true, expected: "router.lan:9000"},
+		{host: "router.lan:9000", port: 9000, expected: "router.lan:9000"},
+		{host: "10.0.0.1", expected: "10.0.0.1:8728"},
+		{host: "2001:db8::1", expected: "[2001:db8::1]:8728"},
+		{host: "[2001:db8::1]", tls: true, expected: "[2001:db8::1]:8729"},
+		{host: "[2001:db8::1]:9000", expected: "[2001:db8::1]:9000"},
+		{host: "fe80::1%ether1", port: 9000, expected: "[fe80::1%ether1]:9000"},
+		{host: "router.lan:8728", port: 9000},
+		{host: ""},
+	}
+
+	// For each Case
+	for _, tc := range cases {
+
+		// Build Address
+		client := &Mikrotik{Host: tc.host, Port: tc.port, TLS: tc.tls}
+		address, err := client.Address()
+
+		// Check Address (an empty expectation means an Error)
+		if tc.expected == "" && err == nil {
+			t.Errorf("Expected host `%s` with port %d to be rejected, got: %s", tc.host, tc.port, address)
+		}
+		if tc.expected != "" && (err != nil || address != tc.expected) {
+			t.Errorf("The address of `%s` does not match what we expected. actual: %s (%v) expected: %s", tc.host, address, err, tc.expected)
+		}
+	}
+}
+
+/**
+ * Test Method for Network Dialers built from the Dial Settings
+ */
+func TestDialerSettings(t *testing.T) {
+
+	// Build Dialer
+	client := &Mikrotik{ConnectTimeout: 5 * time.Second, KeepAlive: -1, BindAddress: "2001:db8::10"}
+	dialer, err := client.dialer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check Dialer
+	expected := &net.Dialer{Timeout: 5 * time.Second, KeepAlive: -1, LocalAddr: &net.TCPAddr{IP: net.ParseIP("2001:db8::10")}}
+	if !reflect.DeepEqual(dialer, expected) {
+		t.Errorf("The dialer does not match what we expected. actual: %+v expected: %+v", dialer, expected)
+	}
+
+	// Check invalid Bind Address
+	client.BindAddress = "eth0"
+	if _, err := client.dialer(); err == nil || !strings.HasPrefix(err.Error(), "bind_address:") {
+		t.Errorf("Expected the bind address to be rejected, got: %v", err)
+	}
+}
+
+/**
+ * Test Method for Commands run through a Dialer
+ */
+func TestDialThroughDialer(t *testing.T) {
+
+	// Build Fake Router
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+		if command[0] != "/ip/pool/print" {
+			return nil, fmt.Errorf("no such command")
+		}
+		return []map[string]string{{".id": "*1", "name": "dhcp", "ranges": "10.0.0.10-10.0.0.99"}}, nil
+	}}
+
+	// Build Client of an IPv6 Router
+	client := &Mikrotik{Host: "2001:db8::1", Username: "admin", Password: "secret", ConnectTimeout: time.Second, Dialer: router}
+
+	// List Pools
+	pools, err := client.ListPools()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check Pools
+	expected := []Pool{{Id: "*1", Name: "dhcp", Ranges: "10.0.0.10-10.0.0.99"}}
+	if !reflect.DeepEqual(pools, expected) {
+		t.Errorf("The pools do not match what we expected. actual: %v expected: %v", pools, expected)
+	}
+
+	// Check Address and Login
+	if !reflect.DeepEqual(router.dialed, []string{"[2001:db8::1]:8728"}) {
+		t.Errorf("The dialed addresses do not match what we expected. actual: %v", router.dialed)
+	}
+	if !reflect.DeepEqual(router.logins(), []string{"=name=admin =password=secret"}) {
+		t.Errorf("The logins do not match what we expected. actual: %v", router.logins())
+	}
+
+	// Check Traps are reported
+	if _, err := client.ListDnsRecords(); err == nil || !strings.Contains(err.Error(), "no such command") {
+		t.Errorf("Expected the trap to be reported, got: %v", err)
+	}
+}
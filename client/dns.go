@@ -5,12 +5,31 @@ import (
 	"log"
 )
 
+// DnsRecord is a static DNS entry. Type is empty for A records (RouterOS omits it), and only the
+// values of its type are set.
 type DnsRecord struct {
 	Id      string `mikrotik:".id"`
 	Name    string `mikrotik:"name"`
+	Regexp  string `mikrotik:"regexp"`
+	Type    string `mikrotik:"type"`
 	Ttl     int    `mikrotik:"ttl,ttlToSeconds"`
 	Address string `mikrotik:"address"`
 	Comment string `mikrotik:"comment"`
+
+	Cname        string `mikrotik:"cname"`
+	MxExchange   string `mikrotik:"mx-exchange"`
+	MxPreference int    `mikrotik:"mx-preference"`
+	Text         string `mikrotik:"text"`
+	SrvTarget    string `mikrotik:"srv-target"`
+	SrvPort      int    `mikrotik:"srv-port"`
+	SrvPriority  int    `mikrotik:"srv-priority"`
+	SrvWeight    int    `mikrotik:"srv-weight"`
+	Ns           string `mikrotik:"ns"`
+	ForwardTo    string `mikrotik:"forward-to"`
+	AddressList  string `mikrotik:"address-list"`
+	// MatchSubdomain is "yes" or "no", left empty to not send it to routers without the property (RouterOS v6)
+	MatchSubdomain string `mikrotik:"match-subdomain"`
+	Dynamic        bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddDnsRecord(d *DnsRecord) (*DnsRecord, error) {
@@ -21,25 +40,33 @@ func (client Mikrotik) AddDnsRecord(d *DnsRecord) (*DnsRecord, error) {
 	}
 	cmd := Marshal("/ip/dns/static/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] /ip/dns/static/add returned %v", r)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return client.FindDnsRecord(d.Name)
+	return client.FindDnsRecord(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindDnsRecord(id string) (*DnsRecord, error) {
+	return client.findDnsRecord("?.id=", id)
+}
+
+func (client Mikrotik) FindDnsRecordByName(name string) (*DnsRecord, error) {
+	return client.findDnsRecord("?name=", name)
 }
 
-func (client Mikrotik) FindDnsRecord(name string) (*DnsRecord, error) {
+func (client Mikrotik) findDnsRecord(query, value string) (*DnsRecord, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/dns/static/print", "?name=" + name}
+	cmd := []string{"/ip/dns/static/print", proplist(DnsRecord{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -54,8 +81,8 @@ func (client Mikrotik) FindDnsRecord(name string) (*DnsRecord, error) {
 		return nil, err
 	}
 
-	if record.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("dns record `%s` not found", name))
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("dns record `%s` not found", value))
 	}
 
 	return &record, nil
@@ -68,10 +95,46 @@ func (client Mikrotik) UpdateDnsRecord(d *DnsRecord) (*DnsRecord, error) {
 		return nil, err
 	}
 	cmd := Marshal("/ip/dns/static/set", d)
+
+	// Marshal skips empty strings and zero numbers: send the values that were removed or set to 0
+	if d.Comment == "" {
+		cmd = append(cmd, "=comment=")
+	}
+	if d.AddressList == "" {
+		cmd = append(cmd, "=address-list=")
+	}
+	switch d.Type {
+	case "CNAME":
+		if d.Cname == "" {
+			cmd = append(cmd, "=cname=")
+		}
+	case "FWD":
+		if d.ForwardTo == "" {
+			cmd = append(cmd, "=forward-to=")
+		}
+	case "MX":
+		if d.MxPreference == 0 {
+			cmd = append(cmd, "=mx-preference=0")
+		}
+	case "SRV":
+		if d.SrvPort == 0 {
+			cmd = append(cmd, "=srv-port=0")
+		}
+		if d.SrvPriority == 0 {
+			cmd = append(cmd, "=srv-priority=0")
+		}
+		if d.SrvWeight == 0 {
+			cmd = append(cmd, "=srv-weight=0")
+		}
+	}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, _ = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
-	return client.FindDnsRecord(d.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.FindDnsRecord(d.Id)
 }
 
 func (client Mikrotik) DeleteDnsRecord(id string) error {
@@ -82,6 +145,29 @@ func (client Mikrotik) DeleteDnsRecord(id string) error {
 	}
 	cmd := []string{"/ip/dns/static/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 	return err
 }
+
+func (client Mikrotik) ListDnsRecords() ([]DnsRecord, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/dns/static/print", proplist(DnsRecord{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dns records: %v", r)
+
+	records := []DnsRecord{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
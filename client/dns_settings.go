@@ -0,0 +1,148 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+/**
+ * Define DNS Settings Structure (the `/ip/dns` Singleton)
+ */
+type DnsSettings struct {
+	Servers              string `mikrotik:"servers"`
+	AllowRemoteRequests  bool   `mikrotik:"allow-remote-requests"`
+	CacheSize            string `mikrotik:"cache-size"`
+	CacheMaxTtl          string `mikrotik:"cache-max-ttl"`
+	UseDohServer         string `mikrotik:"use-doh-server"`
+	VerifyDohCert        bool   `mikrotik:"verify-doh-cert"`
+	MaxConcurrentQueries int    `mikrotik:"max-concurrent-queries"`
+}
+
+// DefaultDnsSettings are the DNS Settings of a Router out of the Box
+var DefaultDnsSettings = DnsSettings{
+	Servers:              "",
+	AllowRemoteRequests:  false,
+	CacheSize:            "2048",
+	CacheMaxTtl:          "1w",
+	UseDohServer:         "",
+	VerifyDohCert:        false,
+	MaxConcurrentQueries: 100,
+}
+
+/**
+ * Function used to READ the DNS Settings of Mikrotik Router
+ */
+func (client Mikrotik) FindDnsSettings() (*DnsSettings, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running READ DNS Settings")
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := []string{"/ip/dns/print", proplist(DnsSettings{})}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	r, err := client.runArgs(c, cmd)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Log Command execution result
+	log.Printf("[DEBUG] DNS Settings READ response: `%v`", r)
+
+	// Initialize DNS Settings
+	settings := DnsSettings{}
+
+	// Unmarshal Response
+	if err := Unmarshal(*r, &settings); err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Return DNS Settings
+	return &settings, nil
+}
+
+/**
+ * Function used to SET the DNS Settings of Mikrotik Router
+ */
+func (client Mikrotik) UpdateDnsSettings(settings *DnsSettings) (*DnsSettings, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running SET DNS Settings")
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := Marshal("/ip/dns/set", settings)
+
+	// Clear the Server Lists (Marshal leaves Empty Values out)
+	if settings.Servers == "" {
+		cmd = append(cmd, "=servers=")
+	}
+	if settings.UseDohServer == "" {
+		cmd = append(cmd, "=use-doh-server=")
+	}
+
+	// Send a Zero Query Limit too (Marshal leaves Zero Values out)
+	if settings.MaxConcurrentQueries == 0 {
+		cmd = append(cmd, "=max-concurrent-queries=0")
+	}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	_, err = client.runArgs(c, cmd)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, fmt.Errorf("cannot set dns settings: %w", err)
+	}
+
+	// Read and Return DNS Settings
+	return client.FindDnsSettings()
+}
+
+/**
+ * Function used to RESET the DNS Settings of Mikrotik Router to their Defaults
+ */
+func (client Mikrotik) ResetDnsSettings() error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running RESET DNS Settings")
+
+	// Set Default DNS Settings
+	defaults := DefaultDnsSettings
+	_, err := client.UpdateDnsSettings(&defaults)
+
+	// Return Result
+	return err
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+/**
+ * Test Method for Resetting the DNS Settings (Empty Server Lists must be sent too)
+ */
+func TestDnsSettingsReset(t *testing.T) {
+
+	// Build Fake Router answering with the Settings it was set to
+	settings := map[string]string{}
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+		if command[0] == "/ip/dns/set" {
+			for _, word := range command[1:] {
+				pair := strings.SplitN(strings.TrimPrefix(word, "="), "=", 2)
+				settings[pair[0]] = pair[1]
+			}
+		}
+		return []map[string]string{settings}, nil
+	}}
+	client := &Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: router}
+
+	// Set then Reset Settings
+	if _, err := client.UpdateDnsSettings(&DnsSettings{Servers: "2001:4860:4860::8888,8.8.8.8", UseDohServer: "https://dns.google/dns-query", CacheSize: "4096"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ResetDnsSettings(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Check Settings
+	actual, err := client.FindDnsSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*actual, DefaultDnsSettings) {
+		t.Errorf("The DNS settings were not reset. actual: %+v expected: %+v", *actual, DefaultDnsSettings)
+	}
+}
+
+/**
+ * Test Method for Setting a Zero Query Limit (Marshal leaves Zero Values out)
+ */
+func TestUpdateDnsSettingsSendsZeroMaxConcurrentQueries(t *testing.T) {
+
+	// Build Fake Router keeping the Set Command
+	var set []string
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+		if command[0] == "/ip/dns/set" {
+			set = command
+		}
+		return []map[string]string{{"cache-size": "2048KiB"}}, nil
+	}}
+	client := &Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: router}
+
+	// Set Settings without Query Limit
+	if _, err := client.UpdateDnsSettings(&DnsSettings{CacheSize: "2048"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Check Command
+	if !strings.Contains(strings.Join(set, " "), "=max-concurrent-queries=0") {
+		t.Errorf("The zero query limit was not sent. actual: %v", set)
+	}
+}
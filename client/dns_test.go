@@ -6,9 +6,40 @@ func TestFindDnsRecord_onNonExistantDnsRecord(t *testing.T) {
 	c := NewClient(GetConfigFromEnv())
 
 	name := "dns record does not exist"
-	_, err := c.FindDnsRecord(name)
+	_, err := c.FindDnsRecordByName(name)
 
 	if _, ok := err.(*NotFound); !ok {
 		t.Errorf("Expecting to receive NotFound error for dns record `%s`, instead error was nil.", name)
 	}
 }
+
+func TestUpdateDnsRecordClearsValues(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	record, err := c.AddDnsRecord(&DnsRecord{
+		Name: "_sip._udp.example.com", Type: "SRV", SrvTarget: "sip.example.com", SrvPort: 5060,
+		SrvPriority: 10, SrvWeight: 5, Comment: "sip", AddressList: "sip-servers",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record.SrvPriority, record.SrvWeight, record.Comment, record.AddressList = 0, 0, "", ""
+	record, err = c.UpdateDnsRecord(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.SrvPriority != 0 || record.SrvWeight != 0 || record.Comment != "" || record.AddressList != "" {
+		t.Errorf("expected the priority, weight, comment and address list to be cleared, got %+v", record)
+	}
+
+	mx, err := c.AddDnsRecord(&DnsRecord{Name: "example.com", Type: "MX", MxExchange: "mail.example.com", MxPreference: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mx.MxPreference = 0
+	if mx, err = c.UpdateDnsRecord(mx); err != nil || mx.MxPreference != 0 {
+		t.Errorf("expected the MX preference to be set to 0, got %+v, %v", mx, err)
+	}
+}
@@ -1,5 +1,12 @@
 package client
 
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-routeros/routeros"
+)
+
 type NotFound struct {
 	s string
 }
@@ -11,3 +18,19 @@ func NewNotFound(text string) error {
 func (e *NotFound) Error() string {
 	return e.s
 }
+
+// IsNotFound reports whether err means the object does not exist (anymore), either because a lookup
+// returned nothing or because the device rejected a command with "no such item".
+func IsNotFound(err error) bool {
+	var notFound *NotFound
+	if errors.As(err, &notFound) {
+		return true
+	}
+
+	var deviceError *routeros.DeviceError
+	if errors.As(err, &deviceError) && deviceError.Sentence != nil {
+		return strings.Contains(deviceError.Sentence.Map["message"], "no such item")
+	}
+
+	return false
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-routeros/routeros"
+	"github.com/go-routeros/routeros/proto"
+)
+
+func TestIsNotFound(t *testing.T) {
+	deviceError := func(message string) error {
+		return &routeros.DeviceError{Sentence: &proto.Sentence{Word: "!trap", Map: map[string]string{"message": message}}}
+	}
+
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"not found", NewNotFound("pool `x` not found"), true},
+		{"wrapped not found", fmt.Errorf("cannot import: %w", NewNotFound("x")), true},
+		{"no such item", deviceError("no such item"), true},
+		{"no such item with code", deviceError("no such item (4)"), true},
+		{"other device error", deviceError("failure: already have such address"), false},
+		{"other error", errors.New("connection refused"), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := IsNotFound(tc.err); actual != tc.expected {
+				t.Errorf("IsNotFound(%v) = %t, expected %t", tc.err, actual, tc.expected)
+			}
+		})
+	}
+}
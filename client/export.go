@@ -0,0 +1,693 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-routeros/routeros"
+	"github.com/go-routeros/routeros/proto"
+)
+
+/**
+ * Define Export Command Structure (one add/set/remove line of a RouterOS `/export`)
+ */
+type ExportCommand struct {
+	Line     int
+	Menu     string
+	Action   string
+	Items    []string
+	Selector []proto.Pair
+	Args     []proto.Pair
+}
+
+/**
+ * Define Export Object Structure (one menu item once all export commands are applied)
+ */
+type ExportObject struct {
+	Pairs []proto.Pair
+
+	// Default is true when the object was not created by the export itself
+	// but modified through `set` (built-in interfaces, singleton menus...)
+	Default bool
+}
+
+/**
+ * Define Export Structure
+ */
+type Export struct {
+	Commands []ExportCommand
+}
+
+// exportIndentation is the indentation RouterOS adds to continuation lines
+const exportIndentation = "    "
+
+/**
+ * Function used to Parse RouterOS `/export` (.rsc) Output
+ */
+func ParseExport(r io.Reader) (*Export, error) {
+
+	// Instantiate Export
+	export := &Export{}
+
+	// Current Menu Context
+	menu := ""
+
+	// Initialize Line Scanner (scripts can be larger than the default buffer)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	// Line Counters
+	lineNumber := 0
+	startLine := 0
+	logicalLine := ""
+
+	// Iterate on Physical Lines
+	for scanner.Scan() {
+
+		// Increment Line Number
+		lineNumber++
+
+		// Get Current Physical Line
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		// If we are on a continuation line, drop indentation (only the export's own
+		// indentation within a quoted value, other spaces being part of the value)
+		if logicalLine != "" && isInsideExportQuote(logicalLine) {
+			line = strings.TrimPrefix(line, exportIndentation)
+		} else if logicalLine != "" {
+			line = strings.TrimLeft(line, " \t")
+		} else {
+			startLine = lineNumber
+		}
+
+		// If Line is Continued on the Next one
+		if isContinuedExportLine(line) {
+			logicalLine += line[:len(line)-1]
+			continue
+		}
+
+		// Build Complete Logical Line
+		logicalLine += line
+		text := strings.TrimSpace(logicalLine)
+		logicalLine = ""
+
+		// Skip Empty Lines and Comments
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		// Split Line into Words
+		words, err := splitExportWords(text)
+
+		// If There is Error
+		if err != nil {
+			return nil, fmt.Errorf("export line %d: %v", startLine, err)
+		}
+
+		// If Line Starts with a Menu Path
+		if strings.HasPrefix(words[0], "/") {
+
+			// Consume Menu Path Words
+			path, rest := splitExportMenuPath(words)
+			menu = path
+
+			// If there is no inline command
+			if len(rest) == 0 {
+				continue
+			}
+
+			words = rest
+		}
+
+		// Scripting Commands are not supported
+		if strings.HasPrefix(words[0], ":") {
+			return nil, fmt.Errorf("export line %d: unsupported scripting command `%s`", startLine, words[0])
+		}
+
+		// A command must be inside a menu context
+		if menu == "" {
+			return nil, fmt.Errorf("export line %d: command `%s` outside of a menu context", startLine, words[0])
+		}
+
+		// Parse Command
+		command, err := parseExportCommand(menu, words)
+
+		// If There is Error
+		if err != nil {
+			return nil, fmt.Errorf("export line %d: %v", startLine, err)
+		}
+
+		// Append Command
+		command.Line = startLine
+		export.Commands = append(export.Commands, *command)
+	}
+
+	// If There is Error (Reading)
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Dangling continuation
+	if logicalLine != "" {
+		return nil, fmt.Errorf("export line %d: unterminated line continuation", startLine)
+	}
+
+	// Return Export
+	return export, nil
+}
+
+/**
+ * Function used to List Menus present in the Export (in order of appearance)
+ */
+func (export *Export) Menus() []string {
+
+	// Initialize Result
+	menus := []string{}
+	seen := map[string]bool{}
+
+	// Iterate on Commands
+	for _, command := range export.Commands {
+
+		// If Menu not yet seen
+		if !seen[command.Menu] {
+			seen[command.Menu] = true
+			menus = append(menus, command.Menu)
+		}
+	}
+
+	// Return Menus
+	return menus
+}
+
+/**
+ * Function used to Compute Menu Objects once add/set/remove Commands are applied
+ */
+func (export *Export) Objects(menu string) []ExportObject {
+
+	// Initialize Objects
+	objects := []ExportObject{}
+
+	// Iterate on Commands of the Menu
+	for _, command := range export.Commands {
+
+		// Skip Other Menus
+		if command.Menu != menu {
+			continue
+		}
+
+		switch command.Action {
+		case "add":
+			objects = append(objects, ExportObject{Pairs: append([]proto.Pair{}, command.Args...)})
+
+		case "set":
+			matched := command.matchingObjects(objects)
+
+			// Setting an object the export did not create (built-in object or singleton menu)
+			if len(matched) == 0 {
+				objects = append(objects, ExportObject{
+					Pairs:   mergeExportPairs(append([]proto.Pair{}, command.Selector...), command.Args),
+					Default: true,
+				})
+				continue
+			}
+
+			for _, idx := range matched {
+				objects[idx].Pairs = mergeExportPairs(objects[idx].Pairs, command.Args)
+			}
+
+		case "remove":
+			matched := command.matchingObjects(objects)
+			removed := map[int]bool{}
+			for _, idx := range matched {
+				removed[idx] = true
+			}
+
+			kept := []ExportObject{}
+			for idx, object := range objects {
+				if !removed[idx] {
+					kept = append(kept, object)
+				}
+			}
+			objects = kept
+		}
+	}
+
+	// Return Objects
+	return objects
+}
+
+/**
+ * Function used to Unmarshal the Objects of an Export Menu into Client Structures
+ */
+func (export *Export) Unmarshal(menu string, v interface{}) error {
+
+	// Build an API like Reply
+	reply := routeros.Reply{Re: []*proto.Sentence{}}
+
+	// Iterate on Objects
+	for _, object := range export.Objects(menu) {
+
+		// Build Sentence
+		sentence := proto.NewSentence()
+		sentence.Word = "!re"
+		sentence.List = object.Pairs
+		for _, pair := range object.Pairs {
+			sentence.Map[pair.Key] = pair.Value
+		}
+
+		// Append Sentence
+		reply.Re = append(reply.Re, sentence)
+	}
+
+	// Unmarshal Reply
+	return Unmarshal(reply, v)
+}
+
+/**
+ * Function used to find indexes of Objects targeted by a set/remove Command
+ */
+func (command ExportCommand) matchingObjects(objects []ExportObject) []int {
+
+	// Initialize Result
+	matched := []int{}
+
+	// Positional items (`set 0 ...`) refer to print numbering
+	if len(command.Items) > 0 {
+		for _, item := range command.Items {
+			if idx, err := strconv.Atoi(item); err == nil && idx >= 0 && idx < len(objects) {
+				matched = append(matched, idx)
+			}
+		}
+		return matched
+	}
+
+	// Without selector, set applies to a singleton menu object
+	if len(command.Selector) == 0 {
+		if command.Action == "set" && len(objects) > 0 {
+			matched = append(matched, 0)
+		}
+		return matched
+	}
+
+	// Find objects matching every selector condition
+	for idx, object := range objects {
+		if exportPairsMatch(object.Pairs, command.Selector) {
+			matched = append(matched, idx)
+		}
+	}
+
+	// Return Result
+	return matched
+}
+
+/**
+ * Function used to check if a (partial) line ends inside a quoted value
+ */
+func isInsideExportQuote(line string) bool {
+
+	// Track Quotes, skipping Escaped Characters within them
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch {
+		case quoted && line[i] == '\\':
+			i++
+		case line[i] == '"':
+			quoted = !quoted
+		}
+	}
+
+	// Return Quote State
+	return quoted
+}
+
+/**
+ * Function used to check if a line ends with a line continuation backslash
+ */
+func isContinuedExportLine(line string) bool {
+
+	// Count Trailing Backslashes
+	count := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		count++
+	}
+
+	// An odd number means the last one is not escaped
+	return count%2 == 1
+}
+
+/**
+ * Function used to split a Menu Path (`/ip firewall filter`) from an inline Command
+ */
+func splitExportMenuPath(words []string) (string, []string) {
+
+	// Initialize Path Parts
+	parts := []string{strings.TrimPrefix(words[0], "/")}
+
+	// Consume Words until a Command or an Argument is found
+	idx := 1
+	for ; idx < len(words); idx++ {
+		word := words[idx]
+		if isExportAction(word) || strings.Contains(word, "=") || strings.HasPrefix(word, "[") {
+			break
+		}
+		parts = append(parts, word)
+	}
+
+	// Build API Path
+	path := "/" + strings.Join(parts, "/")
+	path = strings.TrimSuffix(strings.ReplaceAll(path, "//", "/"), "/")
+	if path == "" {
+		path = "/"
+	}
+
+	// Return Path and Remaining Words
+	return path, words[idx:]
+}
+
+/**
+ * Function used to check if a word is a supported Export Action
+ */
+func isExportAction(word string) bool {
+	return word == "add" || word == "set" || word == "remove"
+}
+
+/**
+ * Function used to Parse one Export Command
+ */
+func parseExportCommand(menu string, words []string) (*ExportCommand, error) {
+
+	// Check Action
+	if !isExportAction(words[0]) {
+		return nil, fmt.Errorf("unsupported command `%s`", words[0])
+	}
+
+	// Instantiate Command
+	command := &ExportCommand{
+		Menu:   menu,
+		Action: words[0],
+	}
+
+	// Iterate on Command Words
+	for _, word := range words[1:] {
+
+		// If Word is a [ find ... ] Selector
+		if strings.HasPrefix(word, "[") {
+			selector, err := parseExportSelector(word)
+			if err != nil {
+				return nil, err
+			}
+			command.Selector = append(command.Selector, selector...)
+			continue
+		}
+
+		// If Word is a key=value Argument
+		if idx := strings.Index(word, "="); idx > 0 {
+			command.Args = append(command.Args, proto.Pair{Key: word[:idx], Value: word[idx+1:]})
+			continue
+		}
+
+		// Positional items can only be targeted by set/remove
+		if command.Action == "add" {
+			return nil, fmt.Errorf("unexpected word `%s` in add command", word)
+		}
+
+		// Numbers refer to print numbering, other words to item names
+		if _, err := strconv.Atoi(word); err == nil {
+			command.Items = append(command.Items, word)
+		} else {
+			command.Selector = append(command.Selector, proto.Pair{Key: "name", Value: word})
+		}
+	}
+
+	// Return Command
+	return command, nil
+}
+
+/**
+ * Function used to Parse a `[ find where key=value ... ]` Selector
+ */
+func parseExportSelector(word string) ([]proto.Pair, error) {
+
+	// Check Brackets
+	if !strings.HasSuffix(word, "]") {
+		return nil, fmt.Errorf("unterminated selector `%s`", word)
+	}
+
+	// Split Inner Expression
+	words, err := splitExportWords(strings.TrimSpace(word[1 : len(word)-1]))
+	if err != nil {
+		return nil, err
+	}
+
+	// Only find expressions are supported
+	if len(words) == 0 || words[0] != "find" {
+		return nil, fmt.Errorf("unsupported selector `%s`", word)
+	}
+
+	// Initialize Result
+	pairs := []proto.Pair{}
+
+	// Iterate on Conditions
+	for _, condition := range words[1:] {
+
+		// Skip Keywords
+		if condition == "where" || condition == "and" {
+			continue
+		}
+
+		// Key=Value Condition
+		if idx := strings.Index(condition, "="); idx > 0 {
+			pairs = append(pairs, proto.Pair{Key: condition[:idx], Value: condition[idx+1:]})
+			continue
+		}
+
+		// A bare property name tests a boolean flag
+		pairs = append(pairs, proto.Pair{Key: condition, Value: "yes"})
+	}
+
+	// Return Conditions
+	return pairs, nil
+}
+
+/**
+ * Function used to split an Export Line into Words, decoding quoted Values
+ */
+func splitExportWords(line string) ([]string, error) {
+
+	// Initialize Result
+	words := []string{}
+
+	// Current Word
+	var word strings.Builder
+	inWord := false
+
+	// Iterate on Characters
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+
+		switch {
+		case ch == ' ' || ch == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+
+		case ch == '[' && !inWord:
+			end, err := findExportBracketEnd(line, i)
+			if err != nil {
+				return nil, err
+			}
+			words = append(words, line[i:end+1])
+			i = end
+
+		case ch == '"':
+			value, end, err := unquoteExportString(line, i)
+			if err != nil {
+				return nil, err
+			}
+			word.WriteString(value)
+			inWord = true
+			i = end
+
+		default:
+			word.WriteByte(ch)
+			inWord = true
+		}
+	}
+
+	// Append Last Word
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	// Return Words
+	return words, nil
+}
+
+/**
+ * Function used to find the closing bracket of a selector, skipping quoted strings
+ */
+func findExportBracketEnd(line string, start int) (int, error) {
+
+	// Bracket Depth
+	depth := 0
+
+	// Iterate on Characters
+	for i := start; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			_, end, err := unquoteExportString(line, i)
+			if err != nil {
+				return 0, err
+			}
+			i = end
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	// Return Error
+	return 0, fmt.Errorf("unterminated selector")
+}
+
+/**
+ * Function used to decode a RouterOS quoted String starting at `start`
+ */
+func unquoteExportString(line string, start int) (string, int, error) {
+
+	// Initialize Value
+	var value strings.Builder
+
+	// Iterate on Characters after the opening quote
+	for i := start + 1; i < len(line); i++ {
+		ch := line[i]
+
+		// Closing Quote
+		if ch == '"' {
+			return value.String(), i, nil
+		}
+
+		// Regular Character
+		if ch != '\\' {
+			value.WriteByte(ch)
+			continue
+		}
+
+		// Escape Sequence
+		i++
+		if i >= len(line) {
+			break
+		}
+
+		switch esc := line[i]; esc {
+		case 'n':
+			value.WriteByte('\n')
+		case 'r':
+			value.WriteByte('\r')
+		case 't':
+			value.WriteByte('\t')
+		case 'a':
+			value.WriteByte('\a')
+		case 'b':
+			value.WriteByte('\b')
+		case 'f':
+			value.WriteByte('\f')
+		case 'v':
+			value.WriteByte('\v')
+		case '_':
+			value.WriteByte(' ')
+		default:
+			// Two hexadecimal digits encode a raw byte
+			if i+1 < len(line) && isHexDigit(esc) && isHexDigit(line[i+1]) {
+				b, _ := strconv.ParseUint(line[i:i+2], 16, 8)
+				value.WriteByte(byte(b))
+				i++
+			} else {
+				value.WriteByte(esc)
+			}
+		}
+	}
+
+	// Return Error
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+/**
+ * Function used to check if a character is an hexadecimal digit
+ */
+func isHexDigit(ch byte) bool {
+	return (ch >= '0' && ch <= '9') || (ch >= 'A' && ch <= 'F') || (ch >= 'a' && ch <= 'f')
+}
+
+/**
+ * Function used to override/append Pairs
+ */
+func mergeExportPairs(pairs []proto.Pair, updates []proto.Pair) []proto.Pair {
+
+	// Iterate on Updates
+	for _, update := range updates {
+
+		// Override Existing Key
+		found := false
+		for idx := range pairs {
+			if pairs[idx].Key == update.Key {
+				pairs[idx].Value = update.Value
+				found = true
+			}
+		}
+
+		// Append New Key
+		if !found {
+			pairs = append(pairs, update)
+		}
+	}
+
+	// Return Pairs
+	return pairs
+}
+
+/**
+ * Function used to check if Object Pairs satisfy every Selector condition
+ */
+func exportPairsMatch(pairs []proto.Pair, selector []proto.Pair) bool {
+
+	// Iterate on Conditions
+	for _, condition := range selector {
+
+		// Find Value of the Object (a missing boolean flag defaults to "no")
+		value, found := "", false
+		for _, pair := range pairs {
+			if pair.Key == condition.Key {
+				value, found = pair.Value, true
+			}
+		}
+		if !found && (condition.Value == "yes" || condition.Value == "no") {
+			value = "no"
+		}
+
+		// Compare booleans regardless of their spelling (yes/true, no/false)
+		if isMikrotikBool(value) && isMikrotikBool(condition.Value) {
+			if mikrotikBoolToBool(value) != mikrotikBoolToBool(condition.Value) {
+				return false
+			}
+			continue
+		}
+
+		// Compare other values as is
+		if value != condition.Value {
+			return false
+		}
+	}
+
+	// Return Result
+	return true
+}
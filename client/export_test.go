@@ -0,0 +1,201 @@
+package client
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testExport = `# jan/02/1970 00:00:00 by RouterOS 7.8
+# software id = ABCD-1234
+#
+/interface bridge
+add comment="LAN bridge" name=br0
+/interface bridge port
+add bridge=br0 interface=ether2
+add bridge=br0 edge=yes interface=ether3 learn=no
+set [ find interface=ether3 ] comment="guest port"
+/ip address
+add address=10.0.0.1/24 interface=br0 network=10.0.0.0
+/ip dhcp-server lease
+add address=10.0.0.10 block-access=yes comment="printer \"hall\"" \
+    mac-address=AA:BB:CC:DD:EE:FF
+/ip dns
+set allow-remote-requests=yes servers=1.1.1.1
+/ip firewall filter
+add action=accept chain=forward comment=allow-web dst-port=80 protocol=tcp
+add action=drop chain=forward comment=temporary
+remove [ find where comment=temporary ]
+/system script
+add name=hello source=":log info \"hello\"\r\
+    \n:put \$a"
+/interface ethernet
+set [ find default-name=ether1 ] comment=WAN
+`
+
+/**
+ * Test Method for Export Parsing into Client Structures
+ */
+func TestParseExportUnmarshal(t *testing.T) {
+
+	// Parse Export
+	export, err := ParseExport(strings.NewReader(testExport))
+
+	// If There is Error
+	if err != nil {
+		t.Fatalf("Error Parsing Export with: %v", err)
+	}
+
+	// Check Bridge Ports
+	ports := []BridgeInterfacePort{}
+	if err := export.Unmarshal("/interface/bridge/port", &ports); err != nil {
+		t.Fatal(err)
+	}
+	expectedPorts := []BridgeInterfacePort{
+		{Bridge: "br0", Interface: "ether2"},
+		{Bridge: "br0", Interface: "ether3", Edge: "yes", Learn: "no", Comment: "guest port"},
+	}
+	if !reflect.DeepEqual(ports, expectedPorts) {
+		t.Errorf("The bridge ports do not match what we expected. actual: %v expected: %v", ports, expectedPorts)
+	}
+
+	// Check IP Addresses
+	addresses := []IpAddress{}
+	if err := export.Unmarshal("/ip/address", &addresses); err != nil {
+		t.Fatal(err)
+	}
+	expectedAddresses := []IpAddress{{Address: "10.0.0.1/24", Interface: "br0", Network: "10.0.0.0"}}
+	if !reflect.DeepEqual(addresses, expectedAddresses) {
+		t.Errorf("The addresses do not match what we expected. actual: %v expected: %v", addresses, expectedAddresses)
+	}
+
+	// Check Leases (line continuation, escaped quotes and yes/no booleans)
+	leases := []DhcpLease{}
+	if err := export.Unmarshal("/ip/dhcp-server/lease", &leases); err != nil {
+		t.Fatal(err)
+	}
+	expectedLeases := []DhcpLease{{Address: "10.0.0.10", MacAddress: "AA:BB:CC:DD:EE:FF", Comment: `printer "hall"`, BlockAccess: true}}
+	if !reflect.DeepEqual(leases, expectedLeases) {
+		t.Errorf("The leases do not match what we expected. actual: %v expected: %v", leases, expectedLeases)
+	}
+
+	// Check Firewall Rules (removed rules are dropped)
+	rules := []FirewallRule{}
+	if err := export.Unmarshal("/ip/firewall/filter", &rules); err != nil {
+		t.Fatal(err)
+	}
+	expectedRules := []FirewallRule{{Chain: "forward", Action: "accept", DestinationPort: 80, Protocol: "tcp"}}
+	if !reflect.DeepEqual(rules, expectedRules) {
+		t.Errorf("The firewall rules do not match what we expected. actual: %v expected: %v", rules, expectedRules)
+	}
+
+	// Check Script Source (escape sequences inside a continued string)
+	script := Script{}
+	if err := export.Unmarshal("/system/script", &script); err != nil {
+		t.Fatal(err)
+	}
+	if expectedSource := ":log info \"hello\"\r\n:put $a"; script.Source != expectedSource {
+		t.Errorf("The script source does not match what we expected. actual: %q expected: %q", script.Source, expectedSource)
+	}
+
+	// Check Objects modified but not created by the Export
+	for _, menu := range []string{"/ip/dns", "/interface/ethernet"} {
+		objects := export.Objects(menu)
+		if len(objects) != 1 || !objects[0].Default {
+			t.Errorf("Expected a single default object in %s, got %v", menu, objects)
+		}
+	}
+
+	// Check Menus
+	expectedMenus := []string{
+		"/interface/bridge",
+		"/interface/bridge/port",
+		"/ip/address",
+		"/ip/dhcp-server/lease",
+		"/ip/dns",
+		"/ip/firewall/filter",
+		"/system/script",
+		"/interface/ethernet",
+	}
+	if menus := export.Menus(); !reflect.DeepEqual(menus, expectedMenus) {
+		t.Errorf("The menus do not match what we expected. actual: %v expected: %v", menus, expectedMenus)
+	}
+}
+
+/**
+ * Test Method for Quoted Values wrapped across Lines
+ */
+func TestParseExportWrappedQuotes(t *testing.T) {
+
+	// Parse Export (the wrapped comment keeps the spaces following the indentation)
+	export, err := ParseExport(strings.NewReader(`/interface bridge
+add comment="bridge of the\
+      guest network" \
+    name=br1
+`))
+	if err != nil {
+		t.Fatalf("Error Parsing Export with: %v", err)
+	}
+
+	// Check Bridges
+	bridges := []BridgeInterface{}
+	if err := export.Unmarshal("/interface/bridge", &bridges); err != nil {
+		t.Fatal(err)
+	}
+	if len(bridges) != 1 || bridges[0].Name != "br1" || bridges[0].Comment != "bridge of the  guest network" {
+		t.Errorf("The bridges do not match what we expected. actual: %v", bridges)
+	}
+}
+
+/**
+ * Test Method for Inline Menu Commands and Positional Items
+ */
+func TestParseExportInlineCommands(t *testing.T) {
+
+	// Parse Export
+	export, err := ParseExport(strings.NewReader(`/ip pool add name=dhcp ranges=10.0.0.100-10.0.0.200
+/ip pool set 0 comment="main pool"
+`))
+
+	// If There is Error
+	if err != nil {
+		t.Fatalf("Error Parsing Export with: %v", err)
+	}
+
+	// Unmarshal Pools
+	pools := []Pool{}
+	if err := export.Unmarshal("/ip/pool", &pools); err != nil {
+		t.Fatal(err)
+	}
+
+	// Check Result
+	expected := []Pool{{Name: "dhcp", Ranges: "10.0.0.100-10.0.0.200", Comment: "main pool"}}
+	if !reflect.DeepEqual(pools, expected) {
+		t.Errorf("The pools do not match what we expected. actual: %v expected: %v", pools, expected)
+	}
+}
+
+/**
+ * Test Method for Export Parsing Errors
+ */
+func TestParseExportErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"command outside menu", "add name=x\n"},
+		{"unterminated string", "/ip pool\nadd name=\"x\n"},
+		{"unterminated selector", "/ip pool\nset [ find name=x comment=y\n"},
+		{"unsupported command", "/ip pool\nprint\n"},
+		{"scripting command", "/ip pool\n:put 1\n"},
+		{"dangling continuation", "/ip pool\nadd name=x \\\n"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ParseExport(strings.NewReader(tc.input)); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+)
+
+/**
+ * Define Fake Router Structure: a Dialer whose Connections are served in memory by a Handler
+ * answering each Command with Rows, or with a Trap when it returns an Error. A Row holding only `ret`
+ * is the Return Value of the Command. New Connections fail with the Refuse Error when it is set.
+ */
+type fakeRouter struct {
+	mutex    sync.Mutex
+	handle   func(command []string) ([]map[string]string, error)
+	refuse   error
+	dialed   []string
+	commands [][]string
+}
+
+/**
+ * Function used to Open an in-memory Connection to the Fake Router
+ */
+func (router *fakeRouter) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+
+	// Record Address
+	router.mutex.Lock()
+	router.dialed = append(router.dialed, address)
+	refuse := router.refuse
+	router.mutex.Unlock()
+
+	// If Router refuses new Connections
+	if refuse != nil {
+		return nil, refuse
+	}
+
+	// Serve Connection
+	client, server := net.Pipe()
+	go router.serve(server)
+
+	// Return Client End
+	return client, nil
+}
+
+/**
+ * Function used to Serve the Sentences of one Connection
+ */
+func (router *fakeRouter) serve(conn net.Conn) {
+	defer conn.Close()
+	reader, writer := bufio.NewReader(conn), bufio.NewWriter(conn)
+
+	// For each Sentence
+	for {
+		words, err := readFakeSentence(reader)
+		if err != nil {
+			return
+		}
+
+		// Split Tag from Command
+		tag, command := "", []string{}
+		for _, word := range words {
+			if strings.HasPrefix(word, ".tag=") {
+				tag = word
+			} else {
+				command = append(command, word)
+			}
+		}
+
+		// Record Command
+		router.mutex.Lock()
+		router.commands = append(router.commands, command)
+		router.mutex.Unlock()
+
+		// Answer Command (logins always succeed)
+		rows, err := []map[string]string(nil), error(nil)
+		if command[0] != "/login" && router.handle != nil {
+			rows, err = router.handle(command)
+		}
+		done := []string{"!done"}
+		for _, row := range rows {
+
+			// Row holding only the Return Value of an ADD is answered on the Done Sentence
+			if ret, ok := row["ret"]; ok && len(row) == 1 {
+				done = append(done, "=ret="+ret)
+				continue
+			}
+
+			sentence := []string{"!re"}
+			for key, value := range row {
+				sentence = append(sentence, "="+key+"="+value)
+			}
+			writeFakeSentence(writer, sentence, tag)
+		}
+		if err != nil {
+			writeFakeSentence(writer, []string{"!trap", "=message=" + err.Error()}, tag)
+		}
+		writeFakeSentence(writer, done, tag)
+		if writer.Flush() != nil {
+			return
+		}
+	}
+}
+
+/**
+ * Function used to get the Logins received by the Fake Router
+ */
+func (router *fakeRouter) logins() []string {
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+
+	logins := []string{}
+	for _, command := range router.commands {
+		if command[0] == "/login" {
+			logins = append(logins, strings.Join(command[1:], " "))
+		}
+	}
+	return logins
+}
+
+/**
+ * Define Fake Menus Structure: a Handler keeping the Rows added to each Menu, so that Add, Set, Remove
+ * and Print Commands behave as on a Router
+ */
+type fakeMenus struct {
+	mutex sync.Mutex
+	rows  map[string][]map[string]string
+	next  int
+}
+
+/**
+ * Function used to Answer a Command from the Rows of its Menu
+ */
+func (menus *fakeMenus) handle(command []string) ([]map[string]string, error) {
+	menus.mutex.Lock()
+	defer menus.mutex.Unlock()
+	if menus.rows == nil {
+		menus.rows = map[string][]map[string]string{}
+	}
+
+	// Split Menu and Verb, Attributes and Queries
+	index := strings.LastIndex(command[0], "/")
+	menu, verb := command[0][:index], command[0][index+1:]
+	attributes, queries := map[string]string{}, map[string]string{}
+	for _, word := range command[1:] {
+		if strings.HasPrefix(word, "=") {
+			pair := strings.SplitN(word[1:], "=", 2)
+			attributes[pair[0]] = pair[1]
+		} else if strings.HasPrefix(word, "?") {
+			pair := strings.SplitN(word[1:], "=", 2)
+			queries[pair[0]] = pair[1]
+		}
+	}
+
+	// Find Row by ID or Name
+	find := func(menu, key string) int {
+		for i, row := range menus.rows[menu] {
+			if row[".id"] == key || row["name"] == key {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch verb {
+	case "add":
+		menus.next++
+		attributes[".id"] = fmt.Sprintf("*%X", menus.next)
+		menus.rows[menu] = append(menus.rows[menu], attributes)
+		return []map[string]string{{"ret": attributes[".id"]}}, nil
+
+	case "save":
+		menus.next++
+		menus.rows["/file"] = append(menus.rows["/file"], map[string]string{
+			".id": fmt.Sprintf("*%X", menus.next), "name": attributes["name"] + ".backup",
+		})
+		return nil, nil
+
+	case "set":
+		i := find(menu, attributes[".id"])
+		if i < 0 {
+			return nil, fmt.Errorf("no such item")
+		}
+		for key, value := range attributes {
+			menus.rows[menu][i][key] = value
+		}
+		return nil, nil
+
+	case "remove":
+		i := find(menu, attributes["numbers"])
+		if i < 0 {
+			return nil, fmt.Errorf("no such item")
+		}
+		menus.rows[menu] = append(menus.rows[menu][:i], menus.rows[menu][i+1:]...)
+		return nil, nil
+
+	case "print":
+		rows := []map[string]string{}
+	rows:
+		for _, row := range menus.rows[menu] {
+			for key, value := range queries {
+				if row[key] != value {
+					continue rows
+				}
+			}
+			copy := map[string]string{}
+			for key, value := range row {
+				copy[key] = value
+			}
+			rows = append(rows, copy)
+		}
+		return rows, nil
+	}
+
+	// Return Error
+	return nil, fmt.Errorf("no such command")
+}
+
+/**
+ * Function used to Read a Sentence of API Words (the Length Encoding of the RouterOS API)
+ */
+func readFakeSentence(reader *bufio.Reader) ([]string, error) {
+	words := []string{}
+	for {
+		first, err := reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		// Decode Length
+		length, extra := int(first), 0
+		switch {
+		case first&0x80 == 0x00:
+		case first&0xC0 == 0x80:
+			length, extra = int(first&0x3F), 1
+		case first&0xE0 == 0xC0:
+			length, extra = int(first&0x1F), 2
+		case first&0xF0 == 0xE0:
+			length, extra = int(first&0x0F), 3
+		default:
+			return nil, fmt.Errorf("unsupported word length prefix %x", first)
+		}
+		for i := 0; i < extra; i++ {
+			next, err := reader.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			length = length<<8 | int(next)
+		}
+
+		// Empty Word ends the Sentence
+		if length == 0 {
+			return words, nil
+		}
+
+		// Read Word
+		word := make([]byte, length)
+		if _, err := io.ReadFull(reader, word); err != nil {
+			return nil, err
+		}
+		words = append(words, string(word))
+	}
+}
+
+/**
+ * Function used to Write a Sentence of API Words
+ */
+func writeFakeSentence(writer *bufio.Writer, words []string, tag string) {
+	if tag != "" {
+		words = append(words, tag)
+	}
+	for _, word := range append(words, "") {
+		length := len(word)
+		switch {
+		case length < 0x80:
+			writer.WriteByte(byte(length))
+		case length < 0x4000:
+			writer.Write([]byte{byte(length>>8) | 0x80, byte(length)})
+		default:
+			writer.Write([]byte{byte(length>>16) | 0xC0, byte(length >> 8), byte(length)})
+		}
+		writer.WriteString(word)
+	}
+}
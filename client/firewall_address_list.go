@@ -0,0 +1,149 @@
+package client
+
+import (
+	"log"
+)
+
+/**
+ * Define Firewall Address List Entry Structure
+ */
+type FirewallAddressListEntry struct {
+	Id       string `mikrotik:".id"`
+	List     string `mikrotik:"list"`
+	Address  string `mikrotik:"address"`
+	Comment  string `mikrotik:"comment"`
+	Disabled bool   `mikrotik:"disabled"`
+	Dynamic  bool   `mikrotik:"dynamic,readonly"`
+}
+
+/**
+ * Function used to List the static Entries of a Firewall Address List from Mikrotik Router
+ */
+func (client Mikrotik) ListFirewallAddressList(list string) ([]FirewallAddressListEntry, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running List Firewall Address List `%s`", list)
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := []string{"/ip/firewall/address-list/print", proplist(FirewallAddressListEntry{}), "?list=" + list}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	r, err := client.runArgs(c, cmd)
+
+	// Log Command execution result
+	log.Printf("[INFO] Firewall Address List response: `%v`", r)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Instantiate Entry Array
+	entries := []FirewallAddressListEntry{}
+
+	// Unmarshall Response
+	err = Unmarshal(*r, &entries)
+
+	// If There is Error (Unmarshalling)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Keep static Entries (dynamic ones are added by firewall rules)
+	static := []FirewallAddressListEntry{}
+	for _, entry := range entries {
+		if !entry.Dynamic {
+			static = append(static, entry)
+		}
+	}
+
+	// Return result
+	return static, nil
+}
+
+/**
+ * Function used to ADD many Firewall Address List Entries on Mikrotik Router in one Batch
+ */
+func (client Mikrotik) AddFirewallAddressListEntries(entries []FirewallAddressListEntry) ([]FirewallAddressListEntry, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running ADD of %d Firewall Address List Entries", len(entries))
+
+	// Generate Mikrotik Commands
+	cmds := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		cmds = append(cmds, Marshal("/ip/firewall/address-list/add", entry))
+	}
+
+	// Run Batch
+	ids, err := client.batchAdd(cmds)
+
+	// Set the IDs of the added Entries (also when others failed)
+	added := []FirewallAddressListEntry{}
+	for i, id := range ids {
+		if id != "" {
+			entry := entries[i]
+			entry.Id = id
+			added = append(added, entry)
+		}
+	}
+
+	// Return added Entries and Error
+	return added, err
+}
+
+/**
+ * Function used to UPDATE many Firewall Address List Entries on Mikrotik Router in one Batch
+ */
+func (client Mikrotik) UpdateFirewallAddressListEntries(entries []FirewallAddressListEntry) error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running UPDATE of %d Firewall Address List Entries", len(entries))
+
+	// Generate Mikrotik Commands
+	cmds := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		cmd := Marshal("/ip/firewall/address-list/set", entry)
+
+		// Clear removed Comment (Marshal skips empty Values)
+		if entry.Comment == "" {
+			cmd = append(cmd, "=comment=")
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	// Run Batch
+	_, err := client.RunBatch(cmds)
+
+	// Return Error
+	return err
+}
+
+/**
+ * Function used to DELETE many Firewall Address List Entries from Mikrotik Router in one Batch
+ */
+func (client Mikrotik) DeleteFirewallAddressListEntries(ids []string) error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running DELETE of %d Firewall Address List Entries", len(ids))
+
+	// Remove Entries in one Batch
+	return client.batchRemove("/ip/firewall/address-list", ids)
+}
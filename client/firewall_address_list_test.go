@@ -0,0 +1,142 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ * Test Method for Firewall Address List Batch ADD, UPDATE and DELETE Operations
+ */
+func TestFirewallAddressListBatchOperations(t *testing.T) {
+
+	// Get Client from Environments Configuration
+	c := NewClient(GetConfigFromEnv())
+
+	// Define Address List Entries
+	list := "test-batch-list"
+	entries := []FirewallAddressListEntry{}
+	for i := 1; i <= 50; i++ {
+		entries = append(entries, FirewallAddressListEntry{List: list, Address: fmt.Sprintf("10.99.0.%d", i), Comment: "batch"})
+	}
+
+	// Adding Entries
+	added, err := c.AddFirewallAddressListEntries(entries)
+
+	// If There is Error
+	if err != nil {
+
+		// Log
+		t.Fatalf("Error Adding Firewall Address List Entries with: %v", err)
+	}
+
+	// Delete Entries at the end
+	defer func() {
+		ids := []string{}
+		for _, entry := range added {
+			ids = append(ids, entry.Id)
+		}
+		if err := c.DeleteFirewallAddressListEntries(ids); err != nil {
+			t.Errorf("Error Deleting Firewall Address List Entries with: %v", err)
+		}
+	}()
+
+	// Check every Entry got an ID
+	for _, entry := range added {
+		if !strings.HasPrefix(entry.Id, "*") {
+			t.Errorf("Entry `%s` was added without ID: `%s`", entry.Address, entry.Id)
+		}
+	}
+
+	// Update Comments
+	for i := range added {
+		added[i].Comment = "updated"
+	}
+	if err := c.UpdateFirewallAddressListEntries(added); err != nil {
+		t.Errorf("Error Updating Firewall Address List Entries with: %v", err)
+	}
+
+	// List Entries
+	found, err := c.ListFirewallAddressList(list)
+
+	// If There is Error
+	if err != nil {
+
+		// Log
+		t.Fatalf("Error Listing Firewall Address List with: %v", err)
+	}
+
+	// Check Entries
+	addresses := []string{}
+	for _, entry := range found {
+		if entry.Comment != "updated" {
+			t.Errorf("Entry `%s` was not updated: `%s`", entry.Address, entry.Comment)
+		}
+		addresses = append(addresses, entry.Address)
+	}
+	sort.Strings(addresses)
+	if len(addresses) != len(entries) {
+		t.Errorf("The listed entries do not match what we expected. actual: %v", addresses)
+	}
+}
+
+/**
+ * Test Method for Batch Error Messages
+ */
+func TestBatchError(t *testing.T) {
+
+	// Build Batch Error
+	err := &BatchError{Failed: []BatchResult{
+		{Command: []string{"/ip/firewall/address-list/add", "=address=x"}, Err: errors.New("invalid value for argument address")},
+	}}
+
+	// Check Message
+	expected := "1 command(s) failed: `/ip/firewall/address-list/add =address=x`: invalid value for argument address"
+	if err.Error() != expected {
+		t.Errorf("The batch error does not match what we expected. actual: %s expected: %s", err.Error(), expected)
+	}
+}
+
+/**
+ * Test Method for Clearing the Comment of Firewall Address List Entries
+ */
+func TestUpdateFirewallAddressListClearsComment(t *testing.T) {
+
+	// Build Client of a Fake Router
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "router", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	// Add commented Entries
+	added, err := c.AddFirewallAddressListEntries([]FirewallAddressListEntry{
+		{List: "clear-comment", Address: "10.99.0.1", Comment: "batch"},
+		{List: "clear-comment", Address: "10.99.0.2", Comment: "batch"},
+	})
+	if err != nil {
+		t.Fatalf("Error Adding Firewall Address List Entries with: %v", err)
+	}
+
+	// Remove Comments
+	for i := range added {
+		added[i].Comment = ""
+	}
+	if err := c.UpdateFirewallAddressListEntries(added); err != nil {
+		t.Fatalf("Error Updating Firewall Address List Entries with: %v", err)
+	}
+
+	// Check Comments were cleared on the Router
+	found, err := c.ListFirewallAddressList("clear-comment")
+	if err != nil {
+		t.Fatalf("Error Listing Firewall Address List with: %v", err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("Expected two entries, got %v", found)
+	}
+	for _, entry := range found {
+		if entry.Comment != "" {
+			t.Errorf("The comment of `%s` was not cleared: `%s`", entry.Address, entry.Comment)
+		}
+	}
+}
@@ -42,6 +42,7 @@ type FirewallMangle struct {
 	ConnectionState        string `mikrotik:"connection-state"`
 	ConnectionNatState     string `mikrotik:"connection-nat-state"`
 	TcpFlags               string `mikrotik:"tcp-flags"`
+	Dynamic                bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -69,7 +70,7 @@ func (client Mikrotik) AddFirewallMangle(firewallrule *FirewallMangle) (*Firewal
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Mangle ADD response: `%v`", r)
@@ -107,13 +108,13 @@ func (client Mikrotik) ListFirewallMangle() ([]FirewallMangle, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/mangle/print"}
+	cmd := []string{"/ip/firewall/mangle/print", proplist(FirewallMangle{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Mangle List response: `%v`", r)
@@ -161,13 +162,13 @@ func (client Mikrotik) FindFirewallMangle(id string) (*FirewallMangle, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/mangle/print", "?.id=" + id}
+	cmd := []string{"/ip/firewall/mangle/print", proplist(FirewallMangle{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Mangle Find response: `%v`", r)
@@ -225,7 +226,7 @@ func (client Mikrotik) UpdateFirewallMangle(firewallrule *FirewallMangle) (*Fire
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Mangle ADD response: `%v`", r)
@@ -266,7 +267,7 @@ func (client Mikrotik) DeleteFirewallMangle(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
@@ -39,6 +39,7 @@ type FirewallNat struct {
 	Log                    bool   `mikrotik:"log"`
 	LogPrefix              string `mikrotik:"log-prefix"`
 	Disabled               bool   `mikrotik:"disabled"`
+	Dynamic                bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -66,7 +67,7 @@ func (client Mikrotik) AddFirewallNat(firewallrule *FirewallNat) (*FirewallNat,
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Nat ADD response: `%v`", r)
@@ -104,13 +105,13 @@ func (client Mikrotik) ListFirewallNat() ([]FirewallNat, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/nat/print"}
+	cmd := []string{"/ip/firewall/nat/print", proplist(FirewallNat{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Nat List response: `%v`", r)
@@ -158,13 +159,13 @@ func (client Mikrotik) FindFirewallNat(id string) (*FirewallNat, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/nat/print", "?.id=" + id}
+	cmd := []string{"/ip/firewall/nat/print", proplist(FirewallNat{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Nat Find response: `%v`", r)
@@ -222,7 +223,7 @@ func (client Mikrotik) UpdateFirewallNat(firewallrule *FirewallNat) (*FirewallNa
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Nat ADD response: `%v`", r)
@@ -263,7 +264,7 @@ func (client Mikrotik) DeleteFirewallNat(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
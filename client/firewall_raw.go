@@ -29,6 +29,7 @@ type FirewallRaw struct {
 	Log                    bool   `mikrotik:"log"`
 	LogPrefix              string `mikrotik:"log-prefix"`
 	Disabled               bool   `mikrotik:"disabled"`
+	Dynamic                bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -56,7 +57,7 @@ func (client Mikrotik) AddFirewallRaw(firewallrule *FirewallRaw) (*FirewallRaw,
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Raw ADD response: `%v`", r)
@@ -94,13 +95,13 @@ func (client Mikrotik) ListFirewallRaw() ([]FirewallRaw, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/raw/print"}
+	cmd := []string{"/ip/firewall/raw/print", proplist(FirewallRaw{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Raw List response: `%v`", r)
@@ -148,13 +149,13 @@ func (client Mikrotik) FindFirewallRaw(id string) (*FirewallRaw, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/raw/print", "?.id=" + id}
+	cmd := []string{"/ip/firewall/raw/print", proplist(FirewallRaw{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Raw Find response: `%v`", r)
@@ -212,7 +213,7 @@ func (client Mikrotik) UpdateFirewallRaw(firewallrule *FirewallRaw) (*FirewallRa
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Raw ADD response: `%v`", r)
@@ -253,7 +254,7 @@ func (client Mikrotik) DeleteFirewallRaw(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
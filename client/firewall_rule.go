@@ -39,6 +39,7 @@ type FirewallRule struct {
 	Log                    bool   `mikrotik:"log"`
 	LogPrefix              string `mikrotik:"log-prefix"`
 	Disabled               bool   `mikrotik:"disabled"`
+	Dynamic                bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -66,7 +67,7 @@ func (client Mikrotik) AddFirewallRule(firewallrule *FirewallRule) (*FirewallRul
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Rule ADD response: `%v`", r)
@@ -104,13 +105,13 @@ func (client Mikrotik) ListFirewallRule() ([]FirewallRule, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/filter/print"}
+	cmd := []string{"/ip/firewall/filter/print", proplist(FirewallRule{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Rule List response: `%v`", r)
@@ -158,13 +159,13 @@ func (client Mikrotik) FindFirewallRule(id string) (*FirewallRule, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/firewall/filter/print", "?.id=" + id}
+	cmd := []string{"/ip/firewall/filter/print", proplist(FirewallRule{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Rule Find response: `%v`", r)
@@ -222,7 +223,7 @@ func (client Mikrotik) UpdateFirewallRule(firewallrule *FirewallRule) (*Firewall
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] Firewall Rule ADD response: `%v`", r)
@@ -263,7 +264,7 @@ func (client Mikrotik) DeleteFirewallRule(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
@@ -3,5 +3,3 @@ module github.com/kube-cloud/terraform-provider-mikrotik/client
 go 1.17
 
 require github.com/go-routeros/routeros v0.0.0-20210123142807-2a44d57c6730
-
-require github.com/joho/godotenv v1.5.1 // indirect
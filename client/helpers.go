@@ -1,30 +1,23 @@
 package client
 
 import (
-	"github.com/joho/godotenv"
-	"os"
 	"testing"
 )
 
 func SkipLegacyBgpIfUnsupported(t *testing.T) {
-	if !IsLegacyBgpSupported() {
+	if !IsLegacyBgpSupported(t) {
 		t.Skip()
 	}
 }
 
-func IsLegacyBgpSupported() bool {
+func IsLegacyBgpSupported(t *testing.T) bool {
 
-	// Load .env File if exists
-	envFile, _ := godotenv.Read("../.env")
+	// Get Legacy BGP Support (from the Environment or the Credentials Profile)
+	legacyBgpSupported, err := Setting("LEGACY_BGP_SUPPORT", "legacy_bgp_support")
 
-	// Get Legacy BGP Support
-	legacyBgpSupported := os.Getenv("LEGACY_BGP_SUPPORT")
-
-	// If OS ENV Not Set
-	if legacyBgpSupported == "" {
-
-		// Try .env
-		legacyBgpSupported = envFile["LEGACY_BGP_SUPPORT"]
+	// If There is Error (Credentials Profile Loading)
+	if err != nil {
+		t.Fatal(err)
 	}
 
 	// If Lecacy BGP Supported
@@ -39,24 +32,19 @@ func IsLegacyBgpSupported() bool {
 }
 
 func SkipIpAddressV6IfUnsupported(t *testing.T) {
-	if !IsIpAddressV6Supported() {
+	if !IsIpAddressV6Supported(t) {
 		t.Skip()
 	}
 }
 
-func IsIpAddressV6Supported() bool {
-
-	// Load .env File if exists
-	envFile, _ := godotenv.Read("../.env")
-
-	// Get IPV6 Supported
-	ipV6Supported := os.Getenv("IP_ADDRESS_V6_SUPPORT")
+func IsIpAddressV6Supported(t *testing.T) bool {
 
-	// If OS ENV Not Set
-	if ipV6Supported == "" {
+	// Get IPV6 Supported (from the Environment or the Credentials Profile)
+	ipV6Supported, err := Setting("IP_ADDRESS_V6_SUPPORT", "ip_address_v6_support")
 
-		// Try .env
-		ipV6Supported = envFile["IP_ADDRESS_V6_SUPPORT"]
+	// If There is Error (Credentials Profile Loading)
+	if err != nil {
+		t.Fatal(err)
 	}
 
 	// If IP V6 Supported
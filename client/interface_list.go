@@ -10,6 +10,7 @@ type InterfaceList struct {
 	Id      string `mikrotik:".id"`
 	Comment string `mikrotik:"comment"`
 	Name    string `mikrotik:"name"`
+	Dynamic bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddInterfaceList(d *InterfaceList) (*InterfaceList, error) {
@@ -20,24 +21,32 @@ func (client Mikrotik) AddInterfaceList(d *InterfaceList) (*InterfaceList, error
 
 	cmd := Marshal("/interface/list/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[DEBUG] command returned: %v", r)
 
-	return client.FindInterfaceList(d.Name)
+	return client.FindInterfaceList(r.Done.Map["ret"])
 }
 
 func (client Mikrotik) FindInterfaceList(id string) (*InterfaceList, error) {
+	return client.findInterfaceList("?.id=", id)
+}
+
+func (client Mikrotik) FindInterfaceListByName(name string) (*InterfaceList, error) {
+	return client.findInterfaceList("?name=", name)
+}
+
+func (client Mikrotik) findInterfaceList(query, value string) (*InterfaceList, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/interface/list/print", "?name=" + id}
+	cmd := []string{"/interface/list/print", proplist(InterfaceList{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -51,7 +60,7 @@ func (client Mikrotik) FindInterfaceList(id string) (*InterfaceList, error) {
 	}
 
 	if record.Id == "" {
-		return nil, NewNotFound(fmt.Sprintf("interface list `%s` not found", id))
+		return nil, NewNotFound(fmt.Sprintf("interface list `%s` not found", value))
 	}
 
 	return &record, nil
@@ -65,13 +74,13 @@ func (client Mikrotik) UpdateInterfaceList(d *InterfaceList) (*InterfaceList, er
 
 	cmd := Marshal("/interface/list/set", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[DEBUG] command returned: %v", r)
 
-	return client.FindInterfaceList(d.Name)
+	return client.FindInterfaceList(d.Id)
 }
 
 func (client Mikrotik) DeleteInterfaceList(id string) error {
@@ -82,7 +91,7 @@ func (client Mikrotik) DeleteInterfaceList(id string) error {
 
 	cmd := []string{"/interface/list/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return err
 	}
@@ -90,3 +99,26 @@ func (client Mikrotik) DeleteInterfaceList(id string) error {
 
 	return nil
 }
+
+func (client Mikrotik) ListInterfaceLists() ([]InterfaceList, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/interface/list/print", proplist(InterfaceList{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found interface lists: %v", r)
+
+	records := []InterfaceList{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -10,6 +10,7 @@ type InterfaceListMember struct {
 	Id        string `mikrotik:".id"`
 	Interface string `mikrotik:"interface"`
 	List      string `mikrotik:"list"`
+	Dynamic   bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddInterfaceListMember(d *InterfaceListMember) (*InterfaceListMember, error) {
@@ -20,7 +21,7 @@ func (client Mikrotik) AddInterfaceListMember(d *InterfaceListMember) (*Interfac
 
 	cmd := Marshal("/interface/list/member/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -36,9 +37,9 @@ func (client Mikrotik) FindInterfaceListMember(id string) (*InterfaceListMember,
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/interface/list/member/print", "?.id=" + id}
+	cmd := []string{"/interface/list/member/print", proplist(InterfaceListMember{}), "?.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -66,7 +67,7 @@ func (client Mikrotik) UpdateInterfaceListMember(d *InterfaceListMember) (*Inter
 
 	cmd := Marshal("/interface/list/member/set", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func (client Mikrotik) DeleteInterfaceListMember(id string) error {
 
 	cmd := []string{"/interface/list/member/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return err
 	}
@@ -91,3 +92,26 @@ func (client Mikrotik) DeleteInterfaceListMember(id string) error {
 
 	return nil
 }
+
+func (client Mikrotik) ListInterfaceListMembers() ([]InterfaceListMember, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/interface/list/member/print", proplist(InterfaceListMember{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found interface list members: %v", r)
+
+	records := []InterfaceListMember{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
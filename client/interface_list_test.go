@@ -13,7 +13,7 @@ func TestAddInterfaceListUpdateAndDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	found, err := c.FindInterfaceList(list.Name)
+	found, err := c.FindInterfaceListByName(list.Name)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -33,11 +33,11 @@ func TestAddInterfaceListUpdateAndDelete(t *testing.T) {
 	}
 
 	// cleanup
-	if err := c.DeleteInterfaceList(list.Name); err != nil {
+	if err := c.DeleteInterfaceList(list.Id); err != nil {
 		t.Error(err)
 	}
 
-	_, err = c.FindInterfaceList(list.Name)
+	_, err = c.FindInterfaceListByName(list.Name)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -12,6 +12,7 @@ type IpAddress struct {
 	Disabled  bool   `mikrotik:"disabled"`
 	Interface string `mikrotik:"interface"`
 	Network   string `mikrotik:"network"`
+	Dynamic   bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddIpAddress(addr *IpAddress) (*IpAddress, error) {
@@ -23,7 +24,7 @@ func (client Mikrotik) AddIpAddress(addr *IpAddress) (*IpAddress, error) {
 
 	cmd := Marshal("/ip/address/add", addr)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] ip address creation response: `%v`", r)
 
@@ -42,9 +43,9 @@ func (client Mikrotik) ListIpAddress() ([]IpAddress, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/address/print"}
+	cmd := []string{"/ip/address/print", proplist(IpAddress{})}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -69,9 +70,9 @@ func (client Mikrotik) FindIpAddress(id string) (*IpAddress, error) {
 		return nil, err
 	}
 
-	cmd := []string{"/ip/address/print", "?.id=" + id}
+	cmd := []string{"/ip/address/print", proplist(IpAddress{}), "?.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] ip address response: %v", r)
 
@@ -102,7 +103,7 @@ func (client Mikrotik) UpdateIpAddress(addr *IpAddress) (*IpAddress, error) {
 
 	cmd := Marshal("/ip/address/set", addr)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -120,6 +121,6 @@ func (client Mikrotik) DeleteIpAddress(id string) error {
 
 	cmd := []string{"/ip/address/remove", "=.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 	return err
 }
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// IpArp is an entry of the ARP table. Static entries pin the MAC address of an IP address,
+// which is required on interfaces in reply-only ARP mode.
+type IpArp struct {
+	Id         string `mikrotik:".id"`
+	Address    string `mikrotik:"address"`
+	MacAddress string `mikrotik:"mac-address"`
+	Interface  string `mikrotik:"interface"`
+	Published  bool   `mikrotik:"published"`
+	Disabled   bool   `mikrotik:"disabled"`
+	Comment    string `mikrotik:"comment"`
+	Dynamic    bool   `mikrotik:"dynamic,readonly"`
+	Complete   bool   `mikrotik:"complete,readonly"`
+	Invalid    bool   `mikrotik:"invalid,readonly"`
+	Status     string `mikrotik:"status,readonly"`
+}
+
+// Ipv6Neighbor is an entry of the IPv6 neighbor table, which RouterOS only fills dynamically
+type Ipv6Neighbor struct {
+	Address    string `mikrotik:"address"`
+	MacAddress string `mikrotik:"mac-address"`
+	Interface  string `mikrotik:"interface"`
+	Router     bool   `mikrotik:"router"`
+	Status     string `mikrotik:"status"`
+}
+
+func (client Mikrotik) AddIpArp(d *IpArp) (*IpArp, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/arp/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindIpArp(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindIpArp(id string) (*IpArp, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/arp/print", proplist(IpArp{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found arp entry: %v", r)
+
+	record := IpArp{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("arp entry `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateIpArp(d *IpArp) (*IpArp, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ip/arp/set", d)
+	if d.Comment == "" {
+		cmd = append(cmd, "=comment=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindIpArp(d.Id)
+}
+
+func (client Mikrotik) DeleteIpArp(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ip/arp/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListIpArp() ([]IpArp, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/arp/print", proplist(IpArp{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found arp entries: %v", r)
+
+	records := []IpArp{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (client Mikrotik) ListIpv6Neighbors() ([]Ipv6Neighbor, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/neighbor/print", proplist(Ipv6Neighbor{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found ipv6 neighbors: %v", r)
+
+	records := []Ipv6Neighbor{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,76 @@
+package client
+
+import "testing"
+
+func TestAddIpArpUpdateAndDelete(t *testing.T) {
+	c := NewClient(GetConfigFromEnv())
+
+	bridge, err := c.AddBridgeInterface(&BridgeInterface{Name: "terraform-arp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteBridgeInterface(bridge.Id)
+
+	arp, err := c.AddIpArp(&IpArp{
+		Address:    "10.0.0.50",
+		MacAddress: "02:00:00:00:00:50",
+		Interface:  bridge.Name,
+		Comment:    "terraform",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if arp.Dynamic {
+		t.Error("expected the arp entry to be static")
+	}
+
+	arp.MacAddress = "02:00:00:00:00:51"
+	arp.Comment = ""
+	updated, err := c.UpdateIpArp(arp)
+	if err != nil {
+		t.Error(err)
+	} else if updated.MacAddress != arp.MacAddress || updated.Comment != "" {
+		t.Errorf("expected mac address %q and no comment, got %q and %q", arp.MacAddress, updated.MacAddress, updated.Comment)
+	}
+
+	entries, err := c.ListIpArp()
+	if err != nil {
+		t.Error(err)
+	}
+	found := false
+	for _, entry := range entries {
+		found = found || entry.Id == arp.Id
+	}
+	if !found {
+		t.Errorf("expected the arp entry %s to be listed", arp.Id)
+	}
+
+	// cleanup
+	if err := c.DeleteIpArp(arp.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindIpArp(arp.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestUpdateIpArpClearsComment(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	arp, err := c.AddIpArp(&IpArp{Address: "10.0.0.20", MacAddress: "11:11:11:11:11:11", Interface: "ether2", Comment: "printer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arp.Comment = ""
+	arp, err = c.UpdateIpArp(arp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if arp.Comment != "" {
+		t.Errorf("expected the comment to be cleared, got %q", arp.Comment)
+	}
+}
@@ -29,6 +29,7 @@ type IpSecIdentity struct {
 	GeneratePolicy      string `mikrotik:"generate-policy"`
 	Comment             string `mikrotik:"comment"`
 	Disabled            bool   `mikrotik:"disabled"`
+	Dynamic             bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -56,7 +57,7 @@ func (client Mikrotik) AddIpSecIdentity(peer *IpSecIdentity) (*IpSecIdentity, er
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Identity ADD response: `%v`", r)
@@ -94,13 +95,13 @@ func (client Mikrotik) ListIpSecIdentity() ([]IpSecIdentity, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/identity/print"}
+	cmd := []string{"/ip/ipsec/identity/print", proplist(IpSecIdentity{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Identity List response: `%v`", r)
@@ -148,13 +149,13 @@ func (client Mikrotik) FindIpSecIdentity(id string) (*IpSecIdentity, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/identity/print", "?.id=" + id}
+	cmd := []string{"/ip/ipsec/identity/print", proplist(IpSecIdentity{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Identity Find response: `%v`", r)
@@ -226,7 +227,7 @@ func (client Mikrotik) UpdateIpSecIdentity(peer *IpSecIdentity) (*IpSecIdentity,
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Identity ADD response: `%v`", r)
@@ -267,7 +268,7 @@ func (client Mikrotik) DeleteIpSecIdentity(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
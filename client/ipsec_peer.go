@@ -18,6 +18,7 @@ type IpSecPeer struct {
 	Passive            bool   `mikrotik:"passive"`
 	LocalAddress       string `mikrotik:"local-address"`
 	Port               int    `mikrotik:"port"`
+	Dynamic            bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -45,7 +46,7 @@ func (client Mikrotik) AddIpSecPeer(peer *IpSecPeer) (*IpSecPeer, error) {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Peer ADD response: `%v`", r)
@@ -83,13 +84,13 @@ func (client Mikrotik) ListIpSecPeer() ([]IpSecPeer, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/peer/print"}
+	cmd := []string{"/ip/ipsec/peer/print", proplist(IpSecPeer{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Peer List response: `%v`", r)
@@ -137,13 +138,13 @@ func (client Mikrotik) FindIpSecPeer(id string) (*IpSecPeer, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/peer/print", "?.id=" + id}
+	cmd := []string{"/ip/ipsec/peer/print", proplist(IpSecPeer{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Peer Find response: `%v`", r)
@@ -208,7 +209,7 @@ func (client Mikrotik) UpdateIpSecPeer(peer *IpSecPeer) (*IpSecPeer, error) {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Peer ADD response: `%v`", r)
@@ -249,7 +250,7 @@ func (client Mikrotik) DeleteIpSecPeer(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
@@ -23,6 +23,7 @@ type IpSecPolicy struct {
 	IpSecProtocol      string `mikrotik:"ipsec-protocols"`
 	Proposal           string `mikrotik:"proposal"`
 	Disabled           bool   `mikrotik:"disabled"`
+	Dynamic            bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -53,7 +54,7 @@ func (client Mikrotik) AddIpSecPolicy(ipsecPolicy *IpSecPolicy) (*IpSecPolicy, e
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy ADD response: `%v`", r)
@@ -91,13 +92,13 @@ func (client Mikrotik) ListIpSecPolicy() ([]IpSecPolicy, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/policy/print"}
+	cmd := []string{"/ip/ipsec/policy/print", proplist(IpSecPolicy{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy List response: `%v`", r)
@@ -145,13 +146,13 @@ func (client Mikrotik) FindIpSecPolicy(id string) (*IpSecPolicy, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/policy/print", "?.id=" + id}
+	cmd := []string{"/ip/ipsec/policy/print", proplist(IpSecPolicy{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy Find response: `%v`", r)
@@ -209,7 +210,7 @@ func (client Mikrotik) UpdateIpSecPolicy(ipsecPolicy *IpSecPolicy) (*IpSecPolicy
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy ADD response: `%v`", r)
@@ -250,7 +251,7 @@ func (client Mikrotik) DeleteIpSecPolicy(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
@@ -9,8 +9,9 @@ import (
  * Define IPSec Policy Group Structure
  */
 type IpSecPolicyGroup struct {
-	Id   string `mikrotik:".id"`
-	Name string `mikrotik:"name"`
+	Id      string `mikrotik:".id"`
+	Name    string `mikrotik:"name"`
+	Dynamic bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -38,7 +39,7 @@ func (client Mikrotik) AddIpSecPolicyGroup(peer *IpSecPolicyGroup) (*IpSecPolicy
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy Group ADD response: `%v`", r)
@@ -76,13 +77,13 @@ func (client Mikrotik) ListIpSecPolicyGroup() ([]IpSecPolicyGroup, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/policy/group/print"}
+	cmd := []string{"/ip/ipsec/policy/group/print", proplist(IpSecPolicyGroup{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy Group List response: `%v`", r)
@@ -130,13 +131,13 @@ func (client Mikrotik) FindIpSecPolicyGroup(id string) (*IpSecPolicyGroup, error
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/policy/group/print", "?.id=" + id}
+	cmd := []string{"/ip/ipsec/policy/group/print", proplist(IpSecPolicyGroup{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy Group Find response: `%v`", r)
@@ -194,7 +195,7 @@ func (client Mikrotik) UpdateIpSecPolicyGroup(peer *IpSecPolicyGroup) (*IpSecPol
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Policy Group ADD response: `%v`", r)
@@ -235,7 +236,7 @@ func (client Mikrotik) DeleteIpSecPolicyGroup(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
@@ -19,6 +19,7 @@ type IpSecProfile struct {
 	Lifetime      string `mikrotik:"lifetime"`
 	NatTraversal  bool   `mikrotik:"nat-traversal"`
 	ProposalCheck string `mikrotik:"proposal-check"`
+	Dynamic       bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -46,7 +47,7 @@ func (client Mikrotik) AddIpSecProfile(profile *IpSecProfile) (*IpSecProfile, er
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Profile ADD response: `%v`", r)
@@ -84,13 +85,13 @@ func (client Mikrotik) ListIpSecProfile() ([]IpSecProfile, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/profile/print"}
+	cmd := []string{"/ip/ipsec/profile/print", proplist(IpSecProfile{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Profile List response: `%v`", r)
@@ -138,13 +139,13 @@ func (client Mikrotik) FindIpSecProfile(id string) (*IpSecProfile, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/profile/print", "?.id=" + id}
+	cmd := []string{"/ip/ipsec/profile/print", proplist(IpSecProfile{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Profile Find response: `%v`", r)
@@ -202,7 +203,7 @@ func (client Mikrotik) UpdateIpSecProfile(profile *IpSecProfile) (*IpSecProfile,
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Profile ADD response: `%v`", r)
@@ -243,7 +244,7 @@ func (client Mikrotik) DeleteIpSecProfile(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
@@ -16,6 +16,7 @@ type IpSecProposal struct {
 	Lifetime       string `mikrotik:"lifetime"`
 	PfsGroup       string `mikrotik:"pfs-group"`
 	Disabled       bool   `mikrotik:"disabled"`
+	Dynamic        bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -43,7 +44,7 @@ func (client Mikrotik) AddIpSecProposal(proposal *IpSecProposal) (*IpSecProposal
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Proposal ADD response: `%v`", r)
@@ -81,13 +82,13 @@ func (client Mikrotik) ListIpSecProposal() ([]IpSecProposal, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/proposal/print"}
+	cmd := []string{"/ip/ipsec/proposal/print", proplist(IpSecProposal{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Proposal List response: `%v`", r)
@@ -135,13 +136,13 @@ func (client Mikrotik) FindIpSecProposal(id string) (*IpSecProposal, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/ipsec/proposal/print", "?.id=" + id}
+	cmd := []string{"/ip/ipsec/proposal/print", proplist(IpSecProposal{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Proposal Find response: `%v`", r)
@@ -199,7 +200,7 @@ func (client Mikrotik) UpdateIpSecProposal(proposal *IpSecProposal) (*IpSecPropo
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] IPSec Proposal ADD response: `%v`", r)
@@ -240,7 +241,7 @@ func (client Mikrotik) DeleteIpSecProposal(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
@@ -15,6 +15,7 @@ type Ipv6Address struct {
 	FromPool  string `mikrotik:"from-pool"`
 	Interface string `mikrotik:"interface"`
 	NoDad     bool   `mikrotik:"no-dad"`
+	Dynamic   bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddIpv6Address(addr *Ipv6Address) (*Ipv6Address, error) {
@@ -24,7 +25,7 @@ func (client Mikrotik) AddIpv6Address(addr *Ipv6Address) (*Ipv6Address, error) {
 	}
 	cmd := Marshal("/ipv6/address/add", addr)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] ipv6 address creation response: `%v`", r)
 
 	if err != nil {
@@ -42,9 +43,9 @@ func (client Mikrotik) ListIpv6Address() ([]Ipv6Address, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ipv6/address/print"}
+	cmd := []string{"/ipv6/address/print", proplist(Ipv6Address{})}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -67,9 +68,9 @@ func (client Mikrotik) FindIpv6Address(id string) (*Ipv6Address, error) {
 		return nil, err
 	}
 
-	cmd := []string{"/ipv6/address/print", "?.id=" + id}
+	cmd := []string{"/ipv6/address/print", proplist(Ipv6Address{}), "?.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] ipv6 address response: %v", r)
 	if err != nil {
 		return nil, err
@@ -96,7 +97,7 @@ func (client Mikrotik) UpdateIpv6Address(addr *Ipv6Address) (*Ipv6Address, error
 
 	cmd := Marshal("/ipv6/address/set", addr)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +114,6 @@ func (client Mikrotik) DeleteIpv6Address(id string) error {
 
 	cmd := []string{"/ipv6/address/remove", "=.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 	return err
 }
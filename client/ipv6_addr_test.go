@@ -6,7 +6,7 @@ import (
 )
 
 func TestAddIpv6AddressAndDeleteIpv6Address(t *testing.T) {
-	if IsLegacyBgpSupported() {
+	if IsLegacyBgpSupported(t) {
 		t.Skip()
 	}
 
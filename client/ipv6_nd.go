@@ -0,0 +1,132 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// Ipv6Nd holds the neighbor discovery and router advertisement settings of an interface (or of `all` interfaces).
+// RaInterval is a duration range (e.g. 3m20s-10m), HopLimit and Mtu a number or `unspecified`.
+// Empty settings are updated to the RouterOS defaults.
+type Ipv6Nd struct {
+	Id                          string `mikrotik:".id"`
+	Interface                   string `mikrotik:"interface"`
+	RaInterval                  string `mikrotik:"ra-interval"`
+	RaDelay                     string `mikrotik:"ra-delay"`
+	RaLifetime                  string `mikrotik:"ra-lifetime"`
+	HopLimit                    string `mikrotik:"hop-limit"`
+	Mtu                         string `mikrotik:"mtu"`
+	ManagedAddressConfiguration bool   `mikrotik:"managed-address-configuration"`
+	OtherConfiguration          bool   `mikrotik:"other-configuration"`
+	AdvertiseMacAddress         bool   `mikrotik:"advertise-mac-address"`
+	AdvertiseDns                bool   `mikrotik:"advertise-dns"`
+	Disabled                    bool   `mikrotik:"disabled"`
+	Default                     bool   `mikrotik:"default,readonly"`
+}
+
+func (client Mikrotik) AddIpv6Nd(d *Ipv6Nd) (*Ipv6Nd, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/nd/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindIpv6Nd(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindIpv6Nd(id string) (*Ipv6Nd, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/nd/print", proplist(Ipv6Nd{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found ipv6 nd: %v", r)
+
+	record := Ipv6Nd{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("ipv6 nd `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateIpv6Nd(d *Ipv6Nd) (*Ipv6Nd, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/nd/set", d)
+	for _, setting := range []struct{ value, property, byDefault string }{
+		{d.RaInterval, "ra-interval", "3m20s-10m"},
+		{d.RaDelay, "ra-delay", "3s"},
+		{d.RaLifetime, "ra-lifetime", "30m"},
+		{d.HopLimit, "hop-limit", "unspecified"},
+		{d.Mtu, "mtu", "unspecified"},
+	} {
+		if setting.value == "" {
+			cmd = append(cmd, fmt.Sprintf("=%s=%s", setting.property, setting.byDefault))
+		}
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindIpv6Nd(d.Id)
+}
+
+func (client Mikrotik) DeleteIpv6Nd(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ipv6/nd/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListIpv6Nd() ([]Ipv6Nd, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/nd/print", proplist(Ipv6Nd{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found ipv6 nd settings: %v", r)
+
+	records := []Ipv6Nd{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
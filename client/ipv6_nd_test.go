@@ -0,0 +1,62 @@
+package client
+
+import "testing"
+
+func TestAddIpv6NdUpdateAndDelete(t *testing.T) {
+	if IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	c := NewClient(GetConfigFromEnv())
+
+	bridge, err := c.AddBridgeInterface(&BridgeInterface{Name: "terraform-ipv6-nd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.DeleteBridgeInterface(bridge.Id)
+
+	nd, err := c.AddIpv6Nd(&Ipv6Nd{
+		Interface:    bridge.Name,
+		AdvertiseDns: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nd.OtherConfiguration = true
+	updated, err := c.UpdateIpv6Nd(nd)
+	if err != nil {
+		t.Error(err)
+	} else if !updated.OtherConfiguration {
+		t.Error("expected the other configuration flag to be set")
+	}
+
+	// cleanup
+	if err := c.DeleteIpv6Nd(nd.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindIpv6Nd(nd.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestUpdateIpv6NdResetsSettings(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	nd, err := c.AddIpv6Nd(&Ipv6Nd{Interface: "ether2", RaInterval: "1m-2m", RaDelay: "1s", RaLifetime: "none", HopLimit: "64", Mtu: "1500"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nd.RaInterval, nd.RaDelay, nd.RaLifetime, nd.HopLimit, nd.Mtu = "", "", "", "", ""
+	nd, err = c.UpdateIpv6Nd(nd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nd.RaInterval != "3m20s-10m" || nd.RaDelay != "3s" || nd.RaLifetime != "30m" || nd.HopLimit != "unspecified" || nd.Mtu != "unspecified" {
+		t.Errorf("expected the settings to be reset to their defaults, got %+v", nd)
+	}
+}
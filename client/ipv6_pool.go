@@ -0,0 +1,116 @@
+package client
+
+import (
+	"fmt"
+	"log"
+)
+
+// Ipv6Pool is a pool of IPv6 prefixes, of PrefixLength, carved out of Prefix.
+// Pools added by DHCPv6 clients receiving a delegated prefix are Dynamic (read-only).
+type Ipv6Pool struct {
+	Id           string `mikrotik:".id"`
+	Name         string `mikrotik:"name"`
+	Prefix       string `mikrotik:"prefix"`
+	PrefixLength int    `mikrotik:"prefix-length"`
+	Comment      string `mikrotik:"comment"`
+	Dynamic      bool   `mikrotik:"dynamic,readonly"`
+}
+
+func (client Mikrotik) AddIpv6Pool(d *Ipv6Pool) (*Ipv6Pool, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/pool/add", d)
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindIpv6Pool(r.Done.Map["ret"])
+}
+
+func (client Mikrotik) FindIpv6Pool(id string) (*Ipv6Pool, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/pool/print", proplist(Ipv6Pool{}), "?.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found ipv6 pool: %v", r)
+
+	record := Ipv6Pool{}
+	err = Unmarshal(*r, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Id == "" {
+		return nil, NewNotFound(fmt.Sprintf("ipv6 pool `%s` not found", id))
+	}
+
+	return &record, nil
+}
+
+func (client Mikrotik) UpdateIpv6Pool(d *Ipv6Pool) (*Ipv6Pool, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := Marshal("/ipv6/pool/set", d)
+	if d.Comment == "" {
+		cmd = append(cmd, "=comment=")
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] command returned: %v", r)
+
+	return client.FindIpv6Pool(d.Id)
+}
+
+func (client Mikrotik) DeleteIpv6Pool(id string) error {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ipv6/pool/remove", "=.id=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+func (client Mikrotik) ListIpv6Pools() ([]Ipv6Pool, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ipv6/pool/print", proplist(Ipv6Pool{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found ipv6 pools: %v", r)
+
+	records := []Ipv6Pool{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import "testing"
+
+func TestAddIpv6PoolUpdateAndDelete(t *testing.T) {
+	if IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	c := NewClient(GetConfigFromEnv())
+
+	pool, err := c.AddIpv6Pool(&Ipv6Pool{
+		Name:         "terraform-ipv6-pool",
+		Prefix:       "2001:db8:100::/48",
+		PrefixLength: 64,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool.PrefixLength = 56
+	updated, err := c.UpdateIpv6Pool(pool)
+	if err != nil {
+		t.Error(err)
+	} else if updated.PrefixLength != 56 {
+		t.Errorf("expected prefix length to be %d, got %d", 56, updated.PrefixLength)
+	}
+
+	// cleanup
+	if err := c.DeleteIpv6Pool(pool.Id); err != nil {
+		t.Error(err)
+	}
+
+	_, err = c.FindIpv6Pool(pool.Id)
+	if !IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestUpdateIpv6PoolClearsComment(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	pool, err := c.AddIpv6Pool(&Ipv6Pool{Name: "customers", Prefix: "2001:db8:100::/48", PrefixLength: 64, Comment: "customers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool.Comment = ""
+	if pool, err = c.UpdateIpv6Pool(pool); err != nil || pool.Comment != "" {
+		t.Errorf("expected the comment to be cleared, got %+v, %v", pool, err)
+	}
+}
@@ -5,14 +5,23 @@ import (
 	"log"
 )
 
+// DhcpLease is a lease of a DHCP server, static unless Dynamic.
+// Dynamic and Hostname (the host name the client sent) are read-only.
 type DhcpLease struct {
-	Id          string `mikrotik:".id"`
-	Address     string `mikrotik:"address"`
-	MacAddress  string `mikrotik:"mac-address"`
-	Comment     string `mikrotik:"comment"`
-	BlockAccess bool   `mikrotik:"block-access"`
-	Dynamic     bool   // TODO:  don't see this listed as a param https://wiki.mikrotik.com/wiki/Manual:IP/DHCP_Server, but our docs list it as one
-	Hostname    string
+	Id           string `mikrotik:".id"`
+	Address      string `mikrotik:"address"`
+	MacAddress   string `mikrotik:"mac-address"`
+	ClientId     string `mikrotik:"client-id"`
+	Server       string `mikrotik:"server"`
+	LeaseTime    string `mikrotik:"lease-time"`
+	RateLimit    string `mikrotik:"rate-limit"`
+	AddressLists string `mikrotik:"address-lists"`
+	DhcpOption   string `mikrotik:"dhcp-option"`
+	UseSrcMac    bool   `mikrotik:"use-src-mac"`
+	Comment      string `mikrotik:"comment"`
+	BlockAccess  bool   `mikrotik:"block-access"`
+	Dynamic      bool   `mikrotik:"dynamic,readonly"`
+	Hostname     string `mikrotik:"host-name,readonly"`
 }
 
 func (client Mikrotik) AddDhcpLease(l *DhcpLease) (*DhcpLease, error) {
@@ -24,7 +33,7 @@ func (client Mikrotik) AddDhcpLease(l *DhcpLease) (*DhcpLease, error) {
 
 	cmd := Marshal("/ip/dhcp-server/lease/add", l)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] Dhcp lease creation response: `%v`", r)
 
@@ -43,9 +52,9 @@ func (client Mikrotik) ListDhcpLeases() ([]DhcpLease, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/dhcp-server/lease/print"}
+	cmd := []string{"/ip/dhcp-server/lease/print", proplist(DhcpLease{})}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -69,9 +78,9 @@ func (client Mikrotik) FindDhcpLease(id string) (*DhcpLease, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/dhcp-server/lease/print", "?.id=" + id}
+	cmd := []string{"/ip/dhcp-server/lease/print", proplist(DhcpLease{}), "?.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] Dhcp lease response: %v", r)
 
@@ -101,8 +110,20 @@ func (client Mikrotik) UpdateDhcpLease(l *DhcpLease) (*DhcpLease, error) {
 	}
 
 	cmd := Marshal("/ip/dhcp-server/lease/set", l)
+	if l.ClientId == "" {
+		cmd = append(cmd, "=client-id=")
+	}
+	if l.RateLimit == "" {
+		cmd = append(cmd, "=rate-limit=")
+	}
+	if l.AddressLists == "" {
+		cmd = append(cmd, "=address-lists=")
+	}
+	if l.DhcpOption == "" {
+		cmd = append(cmd, "=dhcp-option=")
+	}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -120,6 +141,78 @@ func (client Mikrotik) DeleteDhcpLease(id string) error {
 
 	cmd := []string{"/ip/dhcp-server/lease/remove", "=.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
+	return err
+}
+
+// FindDynamicDhcpLease finds the dynamic lease of a MAC address, on any server when server is empty or `all`
+func (client Mikrotik) FindDynamicDhcpLease(macAddress, server string) (*DhcpLease, error) {
+	c, err := client.getMikrotikClient()
+
+	if err != nil {
+		return nil, err
+	}
+	cmd := []string{"/ip/dhcp-server/lease/print", proplist(DhcpLease{}), "?mac-address=" + macAddress, "?dynamic=true"}
+	if server != "" && server != "all" {
+		cmd = append(cmd, "?server="+server)
+	}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found dynamic dhcp leases: %v", r)
+
+	leases := []DhcpLease{}
+	err = Unmarshal(*r, &leases)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leases) == 0 {
+		return nil, NewNotFound(fmt.Sprintf("dynamic dhcp lease of `%s` not found", macAddress))
+	}
+	if len(leases) > 1 {
+		return nil, fmt.Errorf("%d dynamic dhcp leases of `%s` found, set the server to pick one", len(leases), macAddress)
+	}
+
+	return &leases[0], nil
+}
+
+// MakeStaticDhcpLease converts a dynamic lease to a static one, keeping its id
+func (client Mikrotik) MakeStaticDhcpLease(id string) error {
+	c, err := client.getMikrotikClient()
+
+	if err != nil {
+		return err
+	}
+
+	cmd := []string{"/ip/dhcp-server/lease/make-static", "=numbers=" + id}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
 	return err
 }
+
+// AdoptDhcpLease makes the dynamic lease of macAddress (on l.Server) static, then sets it as l.
+// Once the lease is static, it is returned even when setting it fails.
+func (client Mikrotik) AdoptDhcpLease(macAddress string, l *DhcpLease) (*DhcpLease, error) {
+	dynamicLease, err := client.FindDynamicDhcpLease(macAddress, l.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.MakeStaticDhcpLease(dynamicLease.Id); err != nil {
+		return nil, err
+	}
+
+	adopted := *l
+	adopted.Id = dynamicLease.Id
+	lease, err := client.UpdateDhcpLease(&adopted)
+	if err != nil {
+		return &DhcpLease{Id: dynamicLease.Id}, err
+	}
+
+	return lease, nil
+}
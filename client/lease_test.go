@@ -3,6 +3,7 @@ package client
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -29,6 +30,9 @@ func TestAddLeaseAndDeleteLease(t *testing.T) {
 	}
 
 	expectedLease.Id = lease.Id
+	// server and lease time take the router defaults
+	expectedLease.Server = lease.Server
+	expectedLease.LeaseTime = lease.LeaseTime
 
 	if !reflect.DeepEqual(lease, expectedLease) {
 		t.Errorf("The dhcp lease does not match what we expected. actual: %v expected: %v", lease, expectedLease)
@@ -73,3 +77,72 @@ func TestFindDhcpLease_forNonExistantLease(t *testing.T) {
 		t.Errorf("client should have received error indicating the following dns record `%s` was not found. Instead error was nil", leaseId)
 	}
 }
+
+func TestFindDynamicDhcpLease(t *testing.T) {
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+		if command[0] != "/ip/dhcp-server/lease/print" {
+			return nil, nil
+		}
+		for _, word := range command {
+			if word == "?server=lan" {
+				return []map[string]string{{".id": "*2", "mac-address": "11:11:11:11:11:11", "server": "lan", "dynamic": "true"}}, nil
+			}
+		}
+		return []map[string]string{
+			{".id": "*1", "mac-address": "11:11:11:11:11:11", "server": "guest", "dynamic": "true"},
+			{".id": "*2", "mac-address": "11:11:11:11:11:11", "server": "lan", "dynamic": "true"},
+		}, nil
+	}}
+	c := &Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: router}
+
+	if _, err := c.FindDynamicDhcpLease("11:11:11:11:11:11", "all"); err == nil {
+		t.Error("expected an error for leases on several servers, got nil")
+	}
+
+	lease, err := c.FindDynamicDhcpLease("11:11:11:11:11:11", "lan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Id != "*2" || !lease.Dynamic {
+		t.Errorf("unexpected lease: %+v", lease)
+	}
+}
+
+func TestAdoptDhcpLease(t *testing.T) {
+	menus := &fakeMenus{rows: map[string][]map[string]string{
+		"/ip/dhcp-server/lease": {
+			{".id": "*7", "address": "10.0.0.50", "mac-address": "11:11:11:11:11:11", "server": "lan", "dynamic": "true"},
+		},
+	}}
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+		if command[0] == "/ip/dhcp-server/lease/make-static" {
+			menus.rows["/ip/dhcp-server/lease"][0]["dynamic"] = "false"
+			return nil, nil
+		}
+		return menus.handle(command)
+	}}
+	c := &Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: router}
+
+	lease, err := c.AdoptDhcpLease("11:11:11:11:11:11", &DhcpLease{Address: "10.0.0.10", MacAddress: "11-11-11-11-11-11", Server: "lan", Comment: "printer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Id != "*7" || lease.Dynamic || lease.Address != "10.0.0.10" || lease.Comment != "printer" {
+		t.Errorf("unexpected lease: %+v", lease)
+	}
+
+	writes := []string{}
+	for _, command := range router.commands {
+		if strings.HasSuffix(command[0], "/make-static") || strings.HasSuffix(command[0], "/set") {
+			writes = append(writes, command[0]+" "+command[1])
+		}
+	}
+	expected := []string{"/ip/dhcp-server/lease/make-static =numbers=*7", "/ip/dhcp-server/lease/set =.id=*7"}
+	if !reflect.DeepEqual(writes, expected) {
+		t.Errorf("unexpected writes: %v, expected: %v", writes, expected)
+	}
+
+	if _, err := c.AdoptDhcpLease("22:22:22:22:22:22", &DhcpLease{Server: "lan"}); !IsNotFound(err) {
+		t.Errorf("expected no dynamic lease to adopt, got: %v", err)
+	}
+}
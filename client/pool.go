@@ -11,6 +11,7 @@ type Pool struct {
 	Ranges   string `mikrotik:"ranges"`
 	NextPool string `mikrotik:"next-pool"`
 	Comment  string `mikrotik:"comment"`
+	Dynamic  bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddPool(p *Pool) (*Pool, error) {
@@ -21,7 +22,7 @@ func (client Mikrotik) AddPool(p *Pool) (*Pool, error) {
 	}
 	cmd := Marshal("/ip/pool/add", p)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] Pool creation response: `%v`", r)
 
@@ -40,9 +41,9 @@ func (client Mikrotik) ListPools() ([]Pool, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/pool/print"}
+	cmd := []string{"/ip/pool/print", proplist(Pool{})}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -66,10 +67,10 @@ func (client Mikrotik) FindPool(id string) (*Pool, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/pool/print", "?.id=" + id}
+	cmd := []string{"/ip/pool/print", proplist(Pool{}), "?.id=" + id}
 
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] Pool response: %v", r)
 
@@ -96,9 +97,9 @@ func (client Mikrotik) FindPoolByName(name string) (*Pool, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/ip/pool/print", "?name=" + name}
+	cmd := []string{"/ip/pool/print", proplist(Pool{}), "?name=" + name}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] Pool response: %v", r)
 
@@ -129,7 +130,7 @@ func (client Mikrotik) UpdatePool(p *Pool) (*Pool, error) {
 
 	cmd := Marshal("/ip/pool/set", p)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -147,6 +148,6 @@ func (client Mikrotik) DeletePool(id string) error {
 
 	cmd := []string{"/ip/pool/remove", "=.id=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 	return err
 }
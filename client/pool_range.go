@@ -0,0 +1,238 @@
+package client
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+)
+
+// PoolRange is an inclusive range of IPv4 addresses of a pool
+type PoolRange struct {
+	Pool  string
+	First net.IP
+	Last  net.IP
+}
+
+// PoolUsed is an address of a pool in use (`/ip/pool/used`), Owner and Info telling who holds it (e.g. the DHCP server and the MAC address)
+type PoolUsed struct {
+	Pool    string `mikrotik:"pool"`
+	Address string `mikrotik:"address"`
+	Owner   string `mikrotik:"owner"`
+	Info    string `mikrotik:"info"`
+}
+
+// PoolAllocation accounts for the addresses of a pool and of the pools it is chained to by next-pool
+type PoolAllocation struct {
+	Ranges []PoolRange
+	Total  int
+	Used   int
+	Free   []string
+}
+
+// Size is the number of addresses of the range
+func (r PoolRange) Size() int {
+	return int(ipToUint32(r.Last)-ipToUint32(r.First)) + 1
+}
+
+// Contains tells whether ip is in the range
+func (r PoolRange) Contains(ip net.IP) bool {
+	value := ipToUint32(ip)
+	return ip.To4() != nil && value >= ipToUint32(r.First) && value <= ipToUint32(r.Last)
+}
+
+// Overlaps tells whether the range shares addresses with other
+func (r PoolRange) Overlaps(other PoolRange) bool {
+	return ipToUint32(r.First) <= ipToUint32(other.Last) && ipToUint32(other.First) <= ipToUint32(r.Last)
+}
+
+// ParseRanges parses the comma separated ranges of the pool: addresses, ranges (first-last) and prefixes
+func (p Pool) ParseRanges() ([]PoolRange, error) {
+	ranges := []PoolRange{}
+	for _, value := range strings.Split(p.Ranges, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		r, err := parsePoolRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("pool `%s`: %v", p.Name, err)
+		}
+		r.Pool = p.Name
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
+
+func parsePoolRange(value string) (PoolRange, error) {
+	if strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil || network.IP.To4() == nil {
+			return PoolRange{}, fmt.Errorf("invalid range `%s`", value)
+		}
+		first := ipToUint32(network.IP)
+		ones, bits := network.Mask.Size()
+		return PoolRange{First: uint32ToIp(first), Last: uint32ToIp(first + uint32(1<<(bits-ones)) - 1)}, nil
+	}
+
+	bounds := strings.SplitN(value, "-", 2)
+	first, last := net.ParseIP(bounds[0]).To4(), net.ParseIP(bounds[len(bounds)-1]).To4()
+	if first == nil || last == nil || ipToUint32(first) > ipToUint32(last) {
+		return PoolRange{}, fmt.Errorf("invalid range `%s`", value)
+	}
+
+	return PoolRange{First: first, Last: last}, nil
+}
+
+// PoolChain lists the pool of the given name then the pools it is chained to by next-pool, in order
+func (client Mikrotik) PoolChain(name string) ([]Pool, error) {
+	pools, err := client.ListPools()
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]Pool{}
+	for _, pool := range pools {
+		byName[pool.Name] = pool
+	}
+
+	chain := []Pool{}
+	seen := map[string]bool{}
+	for next := name; next != "" && next != "none"; {
+		pool, ok := byName[next]
+		if !ok {
+			return nil, NewNotFound(fmt.Sprintf("pool `%s` not found", next))
+		}
+		if seen[next] {
+			break
+		}
+		seen[next] = true
+		chain = append(chain, pool)
+		next = pool.NextPool
+	}
+
+	return chain, nil
+}
+
+// ListPoolUsed lists the addresses in use of the pool of the given name
+func (client Mikrotik) ListPoolUsed(name string) ([]PoolUsed, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/ip/pool/used/print", proplist(PoolUsed{}), "?pool=" + name}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found used pool addresses: %v", r)
+
+	records := []PoolUsed{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// AllocatePool finds the first count free addresses of the pool of the given name and of the pools chained to it,
+// skipping the addresses in use, those of static DHCP leases and the excluded ones
+func (client Mikrotik) AllocatePool(name string, count int, exclude []string) (*PoolAllocation, error) {
+	chain, err := client.PoolChain(name)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := map[string]bool{}
+	for _, address := range exclude {
+		taken[address] = true
+	}
+
+	ranges := []PoolRange{}
+	for _, pool := range chain {
+		poolRanges, err := pool.ParseRanges()
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, poolRanges...)
+
+		used, err := client.ListPoolUsed(pool.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range used {
+			taken[entry.Address] = true
+		}
+	}
+
+	leases, err := client.ListDhcpLeases()
+	if err != nil {
+		return nil, err
+	}
+	for _, lease := range leases {
+		if !lease.Dynamic {
+			taken[lease.Address] = true
+		}
+	}
+
+	allocation := allocatePoolRanges(ranges, taken, count)
+	if len(allocation.Free) < count {
+		return nil, fmt.Errorf("pool `%s` has %d free addresses, %d requested", name, len(allocation.Free), count)
+	}
+
+	return allocation, nil
+}
+
+// allocatePoolRanges picks the first count addresses of the ranges not taken, and counts the addresses taken within the ranges
+func allocatePoolRanges(ranges []PoolRange, taken map[string]bool, count int) *PoolAllocation {
+	allocation := &PoolAllocation{Ranges: ranges, Free: []string{}}
+
+	for _, r := range ranges {
+		allocation.Total += r.Size()
+	}
+
+	for address := range taken {
+		ip := net.ParseIP(address)
+		for _, r := range ranges {
+			if r.Contains(ip) {
+				allocation.Used++
+				break
+			}
+		}
+	}
+
+	picked := map[string]bool{}
+	for _, r := range ranges {
+		for value := ipToUint32(r.First); len(allocation.Free) < count; value++ {
+			address := uint32ToIp(value).String()
+			if !taken[address] && !picked[address] {
+				picked[address] = true
+				allocation.Free = append(allocation.Free, address)
+			}
+			if value == ipToUint32(r.Last) {
+				break
+			}
+		}
+	}
+
+	return allocation
+}
+
+func ipToUint32(ip net.IP) uint32 {
+	if ip4 := ip.To4(); ip4 != nil {
+		return binary.BigEndian.Uint32(ip4)
+	}
+	return 0
+}
+
+func uint32ToIp(value uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, value)
+	return ip
+}
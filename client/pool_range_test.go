@@ -0,0 +1,79 @@
+package client
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePoolRanges(t *testing.T) {
+	pool := Pool{Name: "dhcp", Ranges: "10.0.0.10-10.0.0.19, 10.0.0.30,10.0.1.0/30"}
+
+	ranges, err := pool.ParseRanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := []string{}
+	total := 0
+	for _, r := range ranges {
+		actual = append(actual, r.Pool+":"+r.First.String()+"-"+r.Last.String())
+		total += r.Size()
+	}
+	expected := []string{"dhcp:10.0.0.10-10.0.0.19", "dhcp:10.0.0.30-10.0.0.30", "dhcp:10.0.1.0-10.0.1.3"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("The ranges do not match what we expected. actual: %v expected: %v", actual, expected)
+	}
+	if total != 15 {
+		t.Errorf("expected the ranges to hold %d addresses, got %d", 15, total)
+	}
+	if !ranges[0].Overlaps(PoolRange{First: ranges[0].Last, Last: ranges[1].First}) || ranges[0].Overlaps(ranges[1]) {
+		t.Errorf("unexpected overlaps of the range %v", ranges[0])
+	}
+
+	for _, invalid := range []string{"10.0.0.20-10.0.0.10", "10.0.0.1-", "dhcp", "2001:db8::/64"} {
+		if _, err := (Pool{Name: "invalid", Ranges: invalid}).ParseRanges(); err == nil {
+			t.Errorf("expected an error for the range %q, got nil", invalid)
+		}
+	}
+}
+
+func TestAllocatePool(t *testing.T) {
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+		switch command[0] {
+		case "/ip/pool/print":
+			return []map[string]string{
+				{".id": "*1", "name": "lan", "ranges": "10.0.0.1-10.0.0.3", "next-pool": "overflow"},
+				{".id": "*2", "name": "overflow", "ranges": "10.0.1.1-10.0.1.3", "next-pool": "lan"},
+			}, nil
+		case "/ip/pool/used/print":
+			if strings.Join(command, " ") == "/ip/pool/used/print =.proplist=pool,address,owner,info ?pool=lan" {
+				return []map[string]string{{"pool": "lan", "address": "10.0.0.1", "owner": "dhcp1"}}, nil
+			}
+			return nil, nil
+		case "/ip/dhcp-server/lease/print":
+			return []map[string]string{
+				{".id": "*1", "address": "10.0.0.3", "dynamic": "false"},
+				{".id": "*2", "address": "10.0.1.2", "dynamic": "true"},
+			}, nil
+		}
+		return nil, nil
+	}}
+	c := &Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: router}
+
+	allocation, err := c.AllocatePool("lan", 3, []string{"10.0.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"10.0.0.2", "10.0.1.2", "10.0.1.3"}; !reflect.DeepEqual(allocation.Free, expected) {
+		t.Errorf("The free addresses do not match what we expected. actual: %v expected: %v", allocation.Free, expected)
+	}
+	if allocation.Total != 6 || allocation.Used != 3 {
+		t.Errorf("expected 6 addresses of which 3 used, got %d of which %d used", allocation.Total, allocation.Used)
+	}
+
+	if _, err := c.AllocatePool("lan", 5, nil); err == nil {
+		t.Error("expected an error for more addresses than free, got nil")
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+/**
+ * Define Query Filter Structure (Property must be equal to Value)
+ */
+type QueryFilter struct {
+	Property string
+	Value    string
+}
+
+/**
+ * Function used to Format Query Filters as `property=value,...`
+ */
+func FormatQueryFilters(filters []QueryFilter) string {
+
+	// Initialize Formatted Filters
+	formatted := make([]string, 0, len(filters))
+
+	// For each Filter
+	for _, filter := range filters {
+
+		// Append Formatted Filter
+		formatted = append(formatted, filter.Property+"="+filter.Value)
+	}
+
+	// Return Formatted Filters
+	return strings.Join(formatted, ",")
+}
+
+/**
+ * Function used to Build the PRINT Command Querying the Menu Items matching every Filter
+ */
+func queryCommand(menu string, filters []QueryFilter, property string) []string {
+
+	// Initialize Command (restricted to the Wanted Property)
+	cmd := []string{menu + "/print", "=.proplist=" + property}
+
+	// For each Filter (consecutive queries are combined with AND)
+	for _, filter := range filters {
+
+		// Append Query Word
+		cmd = append(cmd, "?"+filter.Property+"="+filter.Value)
+	}
+
+	// Return Command
+	return cmd
+}
+
+/**
+ * Function used to Find the Value of a Property on every Menu Item matching the Filters
+ */
+func (client Mikrotik) QueryProperty(menu string, filters []QueryFilter, property string) ([]string, error) {
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := queryCommand(menu, filters, property)
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	r, err := client.runArgs(c, cmd)
+
+	// Log Command execution result
+	log.Printf("[DEBUG] Query response: %v", r)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Initialize Values
+	values := make([]string, 0, len(r.Re))
+
+	// For each Matching Item
+	for _, sentence := range r.Re {
+
+		// Append Property Value
+		values = append(values, sentence.Map[property])
+	}
+
+	// Return Values
+	return values, nil
+}
+
+/**
+ * Function used to Resolve the Value of a Property on the single Menu Item matching the Filters
+ */
+func (client Mikrotik) ResolveProperty(menu string, filters []QueryFilter, property string) (string, error) {
+
+	// Query Matching Items
+	values, err := client.QueryProperty(menu, filters, property)
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return "", err
+	}
+
+	// If No Item Matches
+	if len(values) == 0 {
+
+		// Return Not Found Error
+		return "", NewNotFound(fmt.Sprintf("no item of `%s` matches `%s`", menu, FormatQueryFilters(filters)))
+	}
+
+	// If Several Items Match
+	if len(values) > 1 {
+
+		// Return Ambiguity Error
+		return "", fmt.Errorf("%d items of `%s` match `%s` (%s): add more properties to select a single item",
+			len(values), menu, FormatQueryFilters(filters), strings.Join(values, ", "))
+	}
+
+	// Return Single Value
+	return values[0], nil
+}
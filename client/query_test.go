@@ -0,0 +1,27 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+/**
+ * Test Method for Query Command Generation
+ */
+func TestQueryCommand(t *testing.T) {
+
+	// Build Command
+	filters := []QueryFilter{{"bridge", "br0"}, {"interface", "ether2"}}
+	cmd := queryCommand("/interface/bridge/port", filters, ".id")
+
+	// Check Command
+	expected := []string{"/interface/bridge/port/print", "=.proplist=.id", "?bridge=br0", "?interface=ether2"}
+	if !reflect.DeepEqual(cmd, expected) {
+		t.Errorf("The query command does not match what we expected. actual: %v expected: %v", cmd, expected)
+	}
+
+	// Check Formatted Filters
+	if formatted := FormatQueryFilters(filters); formatted != "bridge=br0,interface=ether2" {
+		t.Errorf("The formatted filters do not match what we expected. actual: %s", formatted)
+	}
+}
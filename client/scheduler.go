@@ -12,18 +12,27 @@ type Scheduler struct {
 	StartDate string `mikrotik:"start-date"`
 	StartTime string `mikrotik:"start-time"`
 	Interval  int    `mikrotik:"interval,ttlToSeconds"`
+	Dynamic   bool   `mikrotik:"dynamic,readonly"`
 }
 
-func (client Mikrotik) FindScheduler(name string) (*Scheduler, error) {
+func (client Mikrotik) FindScheduler(id string) (*Scheduler, error) {
+	return client.findScheduler("?.id=", id)
+}
+
+func (client Mikrotik) FindSchedulerByName(name string) (*Scheduler, error) {
+	return client.findScheduler("?name=", name)
+}
+
+func (client Mikrotik) findScheduler(query, value string) (*Scheduler, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
 
-	cmd := []string{"/system/scheduler/print", "?name=" + name}
+	cmd := []string{"/system/scheduler/print", proplist(Scheduler{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, _ := c.RunArgs(cmd)
+	r, _ := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] Found scheduler from mikrotik api %v", r)
 	scheduler := &Scheduler{}
@@ -34,26 +43,21 @@ func (client Mikrotik) FindScheduler(name string) (*Scheduler, error) {
 	}
 
 	if scheduler.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("scheduler `%s` not found", name))
+		return nil, NewNotFound(fmt.Sprintf("scheduler `%s` not found", value))
 	}
 	return scheduler, err
 }
 
-func (client Mikrotik) DeleteScheduler(name string) error {
+func (client Mikrotik) DeleteScheduler(id string) error {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return err
 	}
 
-	scheduler, err := client.FindScheduler(name)
-
-	if err != nil {
-		return err
-	}
-	cmd := []string{"/system/scheduler/remove", "=numbers=" + scheduler.Id}
+	cmd := []string{"/system/scheduler/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] Remove scheduler from mikrotik api %v", r)
 
 	return err
@@ -69,14 +73,14 @@ func (client Mikrotik) CreateScheduler(s *Scheduler) (*Scheduler, error) {
 	cmd := Marshal("/system/scheduler/add", s)
 
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] /system/scheduler/add returned %v", r)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return client.FindScheduler(s.Name)
+	return client.FindScheduler(r.Done.Map["ret"])
 }
 
 func (client Mikrotik) UpdateScheduler(s *Scheduler) (*Scheduler, error) {
@@ -86,20 +90,37 @@ func (client Mikrotik) UpdateScheduler(s *Scheduler) (*Scheduler, error) {
 		return nil, err
 	}
 
-	scheduler, err := client.FindScheduler(s.Name)
+	cmd := Marshal("/system/scheduler/set", s)
+
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	_, err = client.runArgs(c, cmd)
 
 	if err != nil {
-		return scheduler, err
+		return nil, err
 	}
 
-	cmd := Marshal("/system/scheduler/set", s)
+	return client.FindScheduler(s.Id)
+}
 
+func (client Mikrotik) ListSchedulers() ([]Scheduler, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/system/scheduler/print", proplist(Scheduler{})}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found schedulers: %v", r)
 
+	schedulers := []Scheduler{}
+	err = Unmarshal(*r, &schedulers)
 	if err != nil {
-		return scheduler, err
+		return nil, err
 	}
 
-	return client.FindScheduler(s.Name)
+	return schedulers, nil
 }
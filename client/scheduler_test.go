@@ -38,7 +38,7 @@ func TestCreateUpdateDeleteAndFindScheduler(t *testing.T) {
 		t.Errorf("The updated scheduler does not match what we expected. actual: %v expected: %v", scheduler, expectedScheduler)
 	}
 
-	err = c.DeleteScheduler(schedulerName)
+	err = c.DeleteScheduler(scheduler.Id)
 
 	if err != nil {
 		t.Errorf("Error deleting a scheduler with: %v", err)
@@ -49,7 +49,7 @@ func TestFindScheduler_onNonExistantScript(t *testing.T) {
 	c := NewClient(GetConfigFromEnv())
 
 	name := "scheduler does not exist"
-	_, err := c.FindScheduler(name)
+	_, err := c.FindSchedulerByName(name)
 
 	if _, ok := err.(*NotFound); !ok {
 		t.Errorf("Expecting to receive NotFound error for scheduler `%s`, instead error was nil.", name)
@@ -13,6 +13,7 @@ type Script struct {
 	PolicyString           string `mikrotik:"policy"`
 	DontRequirePermissions bool   `mikrotik:"dont-require-permissions"`
 	Source                 string `source:"source"`
+	Dynamic                bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (s *Script) Policy() []string {
@@ -40,36 +41,32 @@ func (client Mikrotik) CreateScript(name, owner, source string, policies []strin
 		dontReqPermsArg,
 	}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] /system/script/add returned %v", r)
 
 	if err != nil {
 		return nil, err
 	}
-	return client.FindScript(name)
+	return client.FindScript(r.Done.Map["ret"])
 }
 
-func (client Mikrotik) UpdateScript(name, owner, source string, policy []string, dontReqPerms bool) (*Script, error) {
+func (client Mikrotik) UpdateScript(id, name, owner, source string, policy []string, dontReqPerms bool) (*Script, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
 
-	script, err := client.FindScript(name)
-
-	if err != nil {
-		return nil, err
-	}
-
 	policiesString := strings.Join(policy, ",")
-	nameArg := fmt.Sprintf("=numbers=%s", script.Id)
+	idArg := fmt.Sprintf("=numbers=%s", id)
+	nameArg := fmt.Sprintf("=name=%s", name)
 	ownerArg := fmt.Sprintf("=owner=%s", owner)
 	sourceArg := fmt.Sprintf("=source=%s", source)
 	policyArg := fmt.Sprintf("=policy=%s", policiesString)
 	dontReqPermsArg := fmt.Sprintf("=dont-require-permissions=%s", boolToMikrotikBool(dontReqPerms))
 	cmd := []string{
 		"/system/script/set",
+		idArg,
 		nameArg,
 		ownerArg,
 		sourceArg,
@@ -77,43 +74,46 @@ func (client Mikrotik) UpdateScript(name, owner, source string, policy []string,
 		dontReqPermsArg,
 	}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return client.FindScript(name)
+	return client.FindScript(id)
 }
 
-func (client Mikrotik) DeleteScript(name string) error {
+func (client Mikrotik) DeleteScript(id string) error {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return err
 	}
 
-	script, err := client.FindScript(name)
-
-	if err != nil {
-		return err
-	}
-	cmd := []string{"/system/script/remove", "=numbers=" + script.Id}
+	cmd := []string{"/system/script/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	log.Printf("[DEBUG] Remove script from mikrotik api %v", r)
 
 	return err
 }
 
-func (client Mikrotik) FindScript(name string) (*Script, error) {
+func (client Mikrotik) FindScript(id string) (*Script, error) {
+	return client.findScript("?.id=", id)
+}
+
+func (client Mikrotik) FindScriptByName(name string) (*Script, error) {
+	return client.findScript("?name=", name)
+}
+
+func (client Mikrotik) findScript(query, value string) (*Script, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/system/script/print", "?name=" + name}
+	cmd := []string{"/system/script/print", proplist(Script{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, _ := c.RunArgs(cmd)
+	r, _ := client.runArgs(c, cmd)
 
 	log.Printf("[DEBUG] Found script from mikrotik api %v", r)
 	script := &Script{}
@@ -124,8 +124,31 @@ func (client Mikrotik) FindScript(name string) (*Script, error) {
 	}
 
 	if script.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("script `%s` not found", name))
+		return nil, NewNotFound(fmt.Sprintf("script `%s` not found", value))
 	}
 
 	return script, err
 }
+
+func (client Mikrotik) ListScripts() ([]Script, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/system/script/print", proplist(Script{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found scripts: %v", r)
+
+	scripts := []Script{}
+	err = Unmarshal(*r, &scripts)
+	if err != nil {
+		return nil, err
+	}
+
+	return scripts, nil
+}
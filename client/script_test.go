@@ -48,12 +48,12 @@ func TestCreateScriptAndDeleteScript(t *testing.T) {
 
 	expectedScript.Id = script.Id
 
-	defer c.DeleteScript(scriptName)
+	defer c.DeleteScript(script.Id)
 	if !reflect.DeepEqual(*script, expectedScript) {
 		t.Errorf("The script does not match what we expected. actual: %v expected: %v", script, expectedScript)
 	}
 
-	err = c.DeleteScript(scriptName)
+	err = c.DeleteScript(script.Id)
 
 	if err != nil {
 		t.Errorf("Error deleting a script with: %v", err)
@@ -64,7 +64,7 @@ func TestFindScript_onNonExistantScript(t *testing.T) {
 	c := NewClient(GetConfigFromEnv())
 
 	name := "script-not-found"
-	_, err := c.FindScript(name)
+	_, err := c.FindScriptByName(name)
 
 	expectedErrStr := fmt.Sprintf("script `%s` not found", name)
 	if err == nil || err.Error() != expectedErrStr {
@@ -0,0 +1,417 @@
+package client
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-routeros/routeros"
+)
+
+// systemExportFile prefixes the temporary File each Export is written to
+const systemExportFile = "terraform-export"
+
+// fileReadChunkSize is the Number of Bytes of a File read at once
+const fileReadChunkSize = 4096
+
+/**
+ * Define System Backup Structure
+ */
+type SystemBackup struct {
+	Name        string `mikrotik:"name"`
+	Password    string `mikrotik:"password"`
+	Encryption  string `mikrotik:"encryption"`
+	DontEncrypt bool   `mikrotik:"dont-encrypt"`
+}
+
+/**
+ * Define File Structure
+ */
+type File struct {
+	Id           string `mikrotik:".id"`
+	Name         string `mikrotik:"name"`
+	Type         string `mikrotik:"type"`
+	Size         string `mikrotik:"size"`
+	CreationTime string `mikrotik:"creation-time"`
+	Contents     string `mikrotik:"contents"`
+}
+
+/**
+ * Define System Resource Structure (only the RouterOS Version, eg. `7.11.2 (stable)`)
+ */
+type SystemResource struct {
+	Version string `mikrotik:"version"`
+}
+
+/**
+ * Define System Export Options Structure (RouterOS 6 and 7 name the Export Flags differently)
+ */
+type SystemExportOptions struct {
+	Path          string
+	Compact       bool
+	Verbose       bool
+	HideSensitive bool
+}
+
+/**
+ * Function used to SAVE a System Backup on Mikrotik Router
+ */
+func (client Mikrotik) SaveSystemBackup(backup *SystemBackup) (*File, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running SAVE System Backup `%s`", backup.Name)
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command (the Password is not logged)
+	cmd := Marshal("/system/backup/save", backup)
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `/system/backup/save =name=%s`", backup.Name)
+
+	// Run Command (saving a Backup does not change the Configuration, the Connectivity Guard is not armed)
+	r, err := client.unguarded().runArgs(c, cmd)
+
+	// Log Command execution result
+	log.Printf("[INFO] System Backup SAVE response: `%v`", r)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Find and Return Backup File
+	return client.waitForFile(backup.Name + ".backup")
+}
+
+/**
+ * Function used to EXPORT the Configuration of Mikrotik Router (or of one Menu) as Script Text
+ */
+func (client Mikrotik) SystemExport(options SystemExportOptions) (string, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running EXPORT of `%s`", options.Path)
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return "", err
+	}
+
+	// Name the File of this Export (concurrent Exports must not overwrite each other)
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s-%x", systemExportFile, suffix)
+
+	// Get RouterOS Version
+	major, err := client.routerOsMajorVersion()
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return "", err
+	}
+
+	// Generate Mikrotik Command (the API only exports to a File)
+	cmd := []string{strings.TrimSuffix(options.Path, "/") + "/export", "=file=" + name}
+	if options.Verbose {
+		cmd = append(cmd, "=verbose=")
+	}
+	if major < 7 {
+
+		// RouterOS 6 exports every Value, Sensitive ones included, unless told otherwise
+		if options.Compact {
+			cmd = append(cmd, "=compact=")
+		}
+		if options.HideSensitive {
+			cmd = append(cmd, "=hide-sensitive=")
+		}
+	} else {
+
+		// RouterOS 7 exports compact (its plain Export), without Sensitive Values, unless told otherwise
+		if !options.HideSensitive {
+			cmd = append(cmd, "=show-sensitive=")
+		}
+	}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command (writing the temporary File does not change the Configuration, the Connectivity Guard is not armed)
+	r, err := client.unguarded().runArgs(c, cmd)
+
+	// Log Command execution result
+	log.Printf("[INFO] EXPORT response: `%v`", r)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return "", err
+	}
+
+	// Find Export File
+	file, err := client.waitForFile(name + ".rsc")
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return "", err
+	}
+
+	// Read Export Text
+	contents, err := readFile(c, file)
+
+	// Remove Export File
+	cmd = []string{"/file/remove", "=numbers=" + file.Name}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	if _, removeErr := client.unguarded().runArgs(c, cmd); removeErr != nil && !IsNotFound(removeErr) && err == nil {
+		err = removeErr
+	}
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return "", fmt.Errorf("cannot export `%s`: %w", options.Path, err)
+	}
+
+	// Return Export Text
+	return contents, nil
+}
+
+/**
+ * Function used to READ the whole Contents of a File. RouterOS prints only the first KiB of the Contents:
+ * larger Files are read in Chunks, which needs RouterOS 7.
+ */
+func readFile(c *routeros.Client, file *File) (string, error) {
+
+	// If printed Contents are whole
+	size, sizeErr := strconv.Atoi(file.Size)
+	if sizeErr == nil && size <= len(file.Contents) {
+		return file.Contents, nil
+	}
+
+	// Read Chunks until the End of the File
+	contents := strings.Builder{}
+	for {
+
+		// Generate Mikrotik Command
+		cmd := []string{
+			"/file/read",
+			"=file=" + file.Name,
+			"=offset=" + strconv.Itoa(contents.Len()),
+			"=chunk-size=" + strconv.Itoa(fileReadChunkSize),
+		}
+
+		// Log Command to be Run
+		log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+		// Run Command
+		r, err := c.RunArgs(cmd)
+
+		// If There is Error (e.g. RouterOS 6 cannot read Files)
+		if err != nil {
+
+			// Return Error
+			return "", fmt.Errorf("file `%s` is %s bytes, the router printed only %d and cannot read the rest: %w", file.Name, file.Size, len(file.Contents), err)
+		}
+
+		// Append Chunk
+		chunk := ""
+		if len(r.Re) > 0 {
+			chunk = r.Re[0].Map["data"]
+		}
+		contents.WriteString(chunk)
+
+		// If the End of the File is reached (a short Chunk ends a File of unknown Size)
+		if chunk == "" || (sizeErr == nil && contents.Len() >= size) || (sizeErr != nil && len(chunk) < fileReadChunkSize) {
+			return contents.String(), nil
+		}
+	}
+}
+
+/**
+ * Function used to FIND a File on Mikrotik Router by Name
+ */
+func (client Mikrotik) FindFile(name string) (*File, error) {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running FIND File `%s`", name)
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := []string{"/file/print", proplist(File{}), "?name=" + name}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	r, err := client.runArgs(c, cmd)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Instantiate File
+	file := File{}
+
+	// Unmarshall Response
+	err = Unmarshal(*r, &file)
+
+	// If There is Error (Unmarshalling)
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// If File ID Empty (then Not found)
+	if file.Id == "" {
+
+		// Return Not Found Error
+		return nil, NewNotFound(fmt.Sprintf("file `%s` not found", name))
+	}
+
+	// Return result
+	return &file, nil
+}
+
+/**
+ * Function used to DELETE a File from Mikrotik Router by Name
+ */
+func (client Mikrotik) DeleteFile(name string) error {
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running DELETE File `%s`", name)
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return err
+	}
+
+	// Generate Mikrotik Command
+	cmd := []string{"/file/remove", "=numbers=" + name}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command (removing a File does not change the Configuration, the Connectivity Guard is not armed)
+	_, err = client.unguarded().runArgs(c, cmd)
+
+	// Return Error
+	return err
+}
+
+/**
+ * Function used to wait for a File written by the Router to be listed (Files show up asynchronously)
+ */
+func (client Mikrotik) waitForFile(name string) (*File, error) {
+
+	// Read Files from the Router (a cached Listing would never show the File)
+	client.cache = nil
+
+	// Try a few times
+	for attempt := 0; ; attempt++ {
+
+		// Find File
+		file, err := client.FindFile(name)
+
+		// If File is listed, or waiting does not help
+		if err == nil || !IsNotFound(err) || attempt == 10 {
+			return file, err
+		}
+
+		// Wait before next Attempt
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+/**
+ * Function used to get the Major Version of RouterOS (6 or 7)
+ */
+func (client Mikrotik) routerOsMajorVersion() (int, error) {
+
+	// Retrieve Mikrotik Client
+	c, err := client.getMikrotikClient()
+
+	// If There is Error (Client Retrieving)
+	if err != nil {
+
+		// Return Error
+		return 0, err
+	}
+
+	// Generate Mikrotik Command
+	cmd := []string{"/system/resource/print", proplist(SystemResource{})}
+
+	// Log Command to be Run
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+
+	// Run Command
+	r, err := client.runArgs(c, cmd)
+
+	// If There is Error (Command Processing)
+	if err != nil {
+
+		// Return Error
+		return 0, err
+	}
+
+	// Unmarshall Response
+	resource := SystemResource{}
+	if err := Unmarshal(*r, &resource); err != nil {
+		return 0, err
+	}
+
+	// Parse Major Version
+	major, err := strconv.Atoi(strings.SplitN(resource.Version, ".", 2)[0])
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return 0, fmt.Errorf("cannot parse RouterOS version `%s`: %w", resource.Version, err)
+	}
+
+	// Return Major Version
+	return major, nil
+}
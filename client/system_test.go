@@ -0,0 +1,246 @@
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+/**
+ * Test Method for System Backup SAVE and File DELETE Operations
+ */
+func TestSaveAndDeleteSystemBackup(t *testing.T) {
+
+	// Get Client from Environments Configuration
+	c := NewClient(GetConfigFromEnv())
+
+	// Save Backup
+	file, err := c.SaveSystemBackup(&SystemBackup{Name: "testacc-backup", DontEncrypt: true})
+
+	// If There is Error
+	if err != nil {
+
+		// Log
+		t.Fatalf("Error Saving System Backup with: %v", err)
+	}
+
+	// Check Backup File
+	if file.Name != "testacc-backup.backup" {
+		t.Errorf("The backup file does not match what we expected. actual: %s", file.Name)
+	}
+
+	// Delete Backup File
+	if err := c.DeleteFile(file.Name); err != nil {
+		t.Errorf("Error Deleting System Backup with: %v", err)
+	}
+
+	// Check Backup File is gone
+	if _, err := c.FindFile(file.Name); !IsNotFound(err) {
+		t.Errorf("Expected backup file to be deleted, got: %v", err)
+	}
+}
+
+/**
+ * Test Method for System EXPORT Operation
+ */
+func TestSystemExport(t *testing.T) {
+
+	// Get Client from Environments Configuration
+	c := NewClient(GetConfigFromEnv())
+
+	// Export one Menu
+	content, err := c.SystemExport(SystemExportOptions{Path: "/ip/pool", Compact: true, HideSensitive: true})
+
+	// If There is Error
+	if err != nil {
+
+		// Log
+		t.Fatalf("Error Exporting with: %v", err)
+	}
+
+	// Check Export Text
+	if !strings.Contains(content, "/ip pool") {
+		t.Errorf("The export does not match what we expected. actual: %s", content)
+	}
+
+	// Check Export File was removed
+	session, err := c.getMikrotikClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []File{}
+	r, err := session.RunArgs([]string{"/file/print", "=.proplist=name"})
+	if err == nil {
+		err = Unmarshal(*r, &files)
+	}
+	if err != nil {
+		t.Fatalf("Error Listing Files with: %v", err)
+	}
+	for _, file := range files {
+		if strings.HasPrefix(file.Name, systemExportFile) {
+			t.Errorf("Expected export file to be deleted, found: %s", file.Name)
+		}
+	}
+}
+
+/**
+ * Test Method for an EXPORT larger than the Contents the Router prints
+ */
+func TestReadExportInChunks(t *testing.T) {
+
+	// Build Export Text of ~10 KiB
+	export := strings.Builder{}
+	for i := 0; export.Len() < 10000; i++ {
+		fmt.Fprintf(&export, "add name=pool%d ranges=10.%d.%d.10-10.%d.%d.20\n", i, i/256, i%256, i/256, i%256)
+	}
+	text := export.String()
+
+	// Build Fake Router writing Exports to Files
+	files := map[string]string{}
+	router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+		args := map[string]string{}
+		for _, word := range command[1:] {
+			if pair := strings.SplitN(strings.TrimLeft(word, "=?"), "=", 2); len(pair) == 2 {
+				args[pair[0]] = pair[1]
+			}
+		}
+		switch command[0] {
+		case "/system/resource/print":
+			return []map[string]string{{"version": "7.11.2 (stable)"}}, nil
+		case "/ip/pool/export":
+			files[args["file"]+".rsc"] = text
+		case "/file/print":
+			if contents, ok := files[args["name"]]; ok {
+				return []map[string]string{{
+					".id": "*1", "name": args["name"], "size": strconv.Itoa(len(contents)), "contents": contents[:4095],
+				}}, nil
+			}
+		case "/file/read":
+			offset, _ := strconv.Atoi(args["offset"])
+			end, _ := strconv.Atoi(args["chunk-size"])
+			contents := files[args["file"]]
+			if end += offset; end > len(contents) {
+				end = len(contents)
+			}
+			return []map[string]string{{"data": contents[offset:end]}}, nil
+		case "/file/remove":
+			delete(files, args["numbers"])
+		default:
+			return nil, fmt.Errorf("no such command")
+		}
+		return nil, nil
+	}}
+	c := Mikrotik{Host: "router", Username: "admin", Dialer: router}
+	c.EnableConnectivityGuard(5 * time.Minute)
+
+	// Export twice
+	names := map[string]bool{}
+	for i := 0; i < 2; i++ {
+
+		// Export Menu
+		content, err := c.SystemExport(SystemExportOptions{Path: "/ip/pool"})
+
+		// If There is Error
+		if err != nil {
+			t.Fatalf("Error Exporting with: %v", err)
+		}
+
+		// Check Export Text is whole
+		if content != text {
+			t.Fatalf("The export has %d bytes instead of %d", len(content), len(text))
+		}
+	}
+
+	// Check each Export used its own File, removed once read, without arming the Connectivity Guard
+	for _, command := range router.commands {
+		if command[0] == "/ip/pool/export" {
+			names[command[1]] = true
+		}
+		if command[0] == "/system/backup/save" {
+			t.Errorf("The export armed the connectivity guard")
+		}
+	}
+	if len(names) != 2 || len(files) != 0 {
+		t.Errorf("Expected two export files, both removed, got %v and %v left", names, files)
+	}
+}
+
+/**
+ * Test Method for the EXPORT Flags of RouterOS 6 and 7
+ */
+func TestSystemExportFlagsByVersion(t *testing.T) {
+
+	// Define Test Cases (RouterOS 7 exports compact and hides sensitive values by default, verbose is only sent when asked for)
+	cases := []struct {
+		version  string
+		options  SystemExportOptions
+		expected []string
+	}{
+		{"6.49.10 (long-term)", SystemExportOptions{}, []string{}},
+		{"6.49.10 (long-term)", SystemExportOptions{Compact: true, HideSensitive: true}, []string{"=compact=", "=hide-sensitive="}},
+		{"6.49.10 (long-term)", SystemExportOptions{Verbose: true, HideSensitive: true}, []string{"=verbose=", "=hide-sensitive="}},
+		{"7.11.2 (stable)", SystemExportOptions{}, []string{"=show-sensitive="}},
+		{"7.11.2 (stable)", SystemExportOptions{Compact: true, HideSensitive: true}, []string{}},
+		{"7.11.2 (stable)", SystemExportOptions{Verbose: true, HideSensitive: true}, []string{"=verbose="}},
+	}
+
+	// For each Test Case
+	for _, test := range cases {
+
+		// Build Fake Router recording the Export Flags
+		flags := []string{}
+		router := &fakeRouter{handle: func(command []string) ([]map[string]string, error) {
+			switch command[0] {
+			case "/system/resource/print":
+				return []map[string]string{{"version": test.version}}, nil
+			case "/export":
+				flags = append(flags, command[2:]...)
+			case "/file/print":
+				return []map[string]string{{".id": "*1", "name": strings.TrimPrefix(command[len(command)-1], "?name="), "size": "0"}}, nil
+			}
+			return nil, nil
+		}}
+		c := Mikrotik{Host: "router", Username: "admin", Dialer: router}
+
+		// Export Configuration
+		if _, err := c.SystemExport(test.options); err != nil {
+			t.Fatalf("Error Exporting with: %v", err)
+		}
+
+		// Check Flags
+		if !reflect.DeepEqual(flags, test.expected) {
+			t.Errorf("RouterOS %s %+v was exported with %v instead of %v", test.version, test.options, flags, test.expected)
+		}
+	}
+}
+
+/**
+ * Test Method for System Backups and File Removals, which do not arm the Connectivity Guard
+ */
+func TestSystemBackupDoesNotArmConnectivityGuard(t *testing.T) {
+
+	// Build Client of a Fake Router with the Connectivity Guard enabled
+	c, router, guardObjects := guardedFakeRouter()
+
+	// Save and Delete Backup
+	file, err := c.SaveSystemBackup(&SystemBackup{Name: "snapshot", DontEncrypt: true})
+	if err != nil {
+		t.Fatalf("Error Saving System Backup with: %v", err)
+	}
+	if err := c.DeleteFile(file.Name); err != nil {
+		t.Fatalf("Error Deleting System Backup with: %v", err)
+	}
+
+	// Check the Guard was not armed
+	for _, command := range router.commands {
+		if command[0] == "/system/scheduler/add" {
+			t.Fatalf("The backup armed the connectivity guard")
+		}
+	}
+	if count := guardObjects(); count != 0 {
+		t.Errorf("The backup left %d connectivity guard objects", count)
+	}
+}
@@ -17,6 +17,7 @@ type Tftp struct {
 	ReadOnly        bool   `mikrotik:"read-only"`
 	Disabled        bool   `mikrotik:"disabled"`
 	Comment         string `mikrotik:"comment"`
+	Dynamic         bool   `mikrotik:"dynamic,readonly"`
 }
 
 /**
@@ -44,7 +45,7 @@ func (client Mikrotik) AddTftp(tftp *Tftp) (*Tftp, error) {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] TFTP Server ADD response: `%v`", r)
@@ -82,13 +83,13 @@ func (client Mikrotik) ListTftp() ([]Tftp, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/tftp/print"}
+	cmd := []string{"/ip/tftp/print", proplist(Tftp{})}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] TFTP Server List response: `%v`", r)
@@ -136,13 +137,13 @@ func (client Mikrotik) FindTftp(id string) (*Tftp, error) {
 	}
 
 	// Generate Mikrotik Command
-	cmd := []string{"/ip/tftp/print", "?.id=" + id}
+	cmd := []string{"/ip/tftp/print", proplist(Tftp{}), "?.id=" + id}
 
 	// Log Command to be Run
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] TFTP Server Find response: `%v`", r)
@@ -200,7 +201,7 @@ func (client Mikrotik) UpdateTftp(tftp *Tftp) (*Tftp, error) {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	// Log Command execution result
 	log.Printf("[INFO] TFTP Server ADD response: `%v`", r)
@@ -241,7 +242,7 @@ func (client Mikrotik) DeleteTftp(id string) error {
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
 
 	// Run Command
-	_, err = c.RunArgs(cmd)
+	_, err = client.runArgs(c, cmd)
 
 	// Return Error if exists
 	return err
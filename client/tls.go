@@ -0,0 +1,145 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// tlsVersions maps the accepted Minimum TLS Versions to their Identifiers
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+/**
+ * Function used to Build the TLS Configuration of the API-SSL Connection, reporting which
+ * Setting is wrong when a Certificate, Key or Version cannot be used
+ */
+func (client *Mikrotik) TLSConfig() (*tls.Config, error) {
+
+	// Initialize Configuration
+	config := &tls.Config{
+		InsecureSkipVerify: client.Insecure,
+		ServerName:         client.ServerName,
+	}
+
+	// If a Minimum Version is set
+	if client.MinTLSVersion != "" {
+
+		// Find Version
+		version, ok := tlsVersions[client.MinTLSVersion]
+
+		// If Version is unknown
+		if !ok {
+
+			// Return Error
+			return nil, fmt.Errorf("tls_min_version: unsupported TLS version `%s`, expected 1.0, 1.1, 1.2 or 1.3", client.MinTLSVersion)
+		}
+
+		// Set Minimum Version
+		config.MinVersion = version
+	}
+
+	// If a CA is set
+	if client.CA != "" || client.CAPem != "" {
+
+		// Initialize Pool
+		config.RootCAs = x509.NewCertPool()
+
+		// Add CA File
+		if client.CA != "" {
+
+			// Read CA File
+			pem, err := ioutil.ReadFile(client.CA)
+
+			// If There is Error
+			if err != nil {
+
+				// Return Error
+				return nil, fmt.Errorf("ca_certificate: %w", err)
+			}
+
+			// If File holds no Certificate
+			if !config.RootCAs.AppendCertsFromPEM(pem) {
+
+				// Return Error
+				return nil, fmt.Errorf("ca_certificate: no PEM certificate could be parsed from %s", client.CA)
+			}
+		}
+
+		// Add inline CA
+		if client.CAPem != "" && !config.RootCAs.AppendCertsFromPEM([]byte(client.CAPem)) {
+
+			// Return Error
+			return nil, fmt.Errorf("ca_certificate_pem: no PEM certificate could be parsed")
+		}
+	}
+
+	// If only one of the Client Certificate and Key is set
+	if (client.ClientCertificate == "") != (client.ClientKey == "") {
+
+		// Return Error
+		return nil, fmt.Errorf("client_certificate and client_key must be set together")
+	}
+
+	// If a Client Certificate is set
+	if client.ClientCertificate != "" {
+
+		// Load Certificate
+		certificate, err := readPem("client_certificate", client.ClientCertificate)
+		if err != nil {
+			return nil, err
+		}
+
+		// Load Key
+		key, err := readPem("client_key", client.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// Parse Key Pair
+		pair, err := tls.X509KeyPair(certificate, key)
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return nil, fmt.Errorf("client_certificate/client_key: %w", err)
+		}
+
+		// Present Key Pair
+		config.Certificates = []tls.Certificate{pair}
+	}
+
+	// Return Configuration
+	return config, nil
+}
+
+/**
+ * Function used to get PEM Contents given either inline or as a File Path
+ */
+func readPem(setting string, value string) ([]byte, error) {
+
+	// If Value is inline PEM
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	// Read File
+	pem, err := ioutil.ReadFile(value)
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return nil, fmt.Errorf("%s: %w", setting, err)
+	}
+
+	// Return File Contents
+	return pem, nil
+}
@@ -9,12 +9,14 @@ import (
 type VlanInterface struct {
 	Id            string `mikrotik:".id"`
 	Interface     string `mikrotik:"interface"`
-	Mtu           int    `mikrotik:"mtu`
+	Mtu           int    `mikrotik:"mtu"`
 	Name          string `mikrotik:"name"`
 	Disabled      bool   `mikrotik:"disabled"`
 	UseServiceTag bool   `mikrotik:"use-service-tag"`
 	VlanId        int    `mikrotik:"vlan-id"`
+	Arp           string `mikrotik:"arp"`
 	Comment       string `mikrotik:"comment"`
+	Dynamic       bool   `mikrotik:"dynamic,readonly"`
 }
 
 func (client Mikrotik) AddVlanInterface(d *VlanInterface) (*VlanInterface, error) {
@@ -25,13 +27,13 @@ func (client Mikrotik) AddVlanInterface(d *VlanInterface) (*VlanInterface, error
 
 	cmd := Marshal("/interface/vlan/add", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[DEBUG] command returned: %v", r)
 
-	return client.FindVlanInterface(d.Name)
+	return client.FindVlanInterface(r.Done.Map["ret"])
 }
 
 func (client Mikrotik) UpdateVlanInterface(d *VlanInterface) (*VlanInterface, error) {
@@ -42,24 +44,32 @@ func (client Mikrotik) UpdateVlanInterface(d *VlanInterface) (*VlanInterface, er
 
 	cmd := Marshal("/interface/vlan/set", d)
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[DEBUG] command returned: %v", r)
 
-	return client.FindVlanInterface(d.Name)
+	return client.FindVlanInterface(d.Id)
 }
 
-func (client Mikrotik) FindVlanInterface(name string) (*VlanInterface, error) {
+func (client Mikrotik) FindVlanInterface(id string) (*VlanInterface, error) {
+	return client.findVlanInterface("?.id=", id)
+}
+
+func (client Mikrotik) FindVlanInterfaceByName(name string) (*VlanInterface, error) {
+	return client.findVlanInterface("?name=", name)
+}
+
+func (client Mikrotik) findVlanInterface(query, value string) (*VlanInterface, error) {
 	c, err := client.getMikrotikClient()
 
 	if err != nil {
 		return nil, err
 	}
-	cmd := []string{"/interface/vlan/print", "?name=" + name}
+	cmd := []string{"/interface/vlan/print", proplist(VlanInterface{}), query + value}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 
 	if err != nil {
 		return nil, err
@@ -74,21 +84,21 @@ func (client Mikrotik) FindVlanInterface(name string) (*VlanInterface, error) {
 	}
 
 	if record.Name == "" {
-		return nil, NewNotFound(fmt.Sprintf("vlan interface `%s` not found", name))
+		return nil, NewNotFound(fmt.Sprintf("vlan interface `%s` not found", value))
 	}
 
 	return &record, nil
 }
 
-func (client Mikrotik) DeleteVlanInterface(name string) error {
+func (client Mikrotik) DeleteVlanInterface(id string) error {
 	c, err := client.getMikrotikClient()
 	if err != nil {
 		return err
 	}
 
-	cmd := []string{"/interface/vlan/remove", "=numbers=" + name}
+	cmd := []string{"/interface/vlan/remove", "=numbers=" + id}
 	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
-	r, err := c.RunArgs(cmd)
+	r, err := client.runArgs(c, cmd)
 	if err != nil {
 		return err
 	}
@@ -96,3 +106,26 @@ func (client Mikrotik) DeleteVlanInterface(name string) error {
 
 	return nil
 }
+
+func (client Mikrotik) ListVlanInterfaces() ([]VlanInterface, error) {
+	c, err := client.getMikrotikClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"/interface/vlan/print", proplist(VlanInterface{})}
+	log.Printf("[INFO] Running the mikrotik command: `%s`", cmd)
+	r, err := client.runArgs(c, cmd)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Found vlan interfaces: %v", r)
+
+	records := []VlanInterface{}
+	err = Unmarshal(*r, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
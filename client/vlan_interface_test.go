@@ -18,7 +18,7 @@ func TestAddVlanInterfaceUpdateAndDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foundInterface, err := c.FindVlanInterface(name)
+	foundInterface, err := c.FindVlanInterfaceByName(name)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -41,12 +41,25 @@ func TestAddVlanInterfaceUpdateAndDelete(t *testing.T) {
 	}
 
 	// cleanup
-	if err := c.DeleteVlanInterface(iface.Name); err != nil {
+	if err := c.DeleteVlanInterface(iface.Id); err != nil {
 		t.Error(err)
 	}
 
-	_, err = c.FindVlanInterface(name)
+	_, err = c.FindVlanInterfaceByName(name)
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
 }
+
+func TestAddVlanInterfaceSendsMtu(t *testing.T) {
+	menus := &fakeMenus{}
+	c := Mikrotik{Host: "10.0.0.1", Username: "admin", Dialer: &fakeRouter{handle: menus.handle}}
+
+	iface, err := c.AddVlanInterface(&VlanInterface{Name: "vlan-20", Interface: "ether1", VlanId: 20, Mtu: 1496})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iface.Mtu != 1496 {
+		t.Errorf("expected mtu to be %d, got %d", 1496, iface.Mtu)
+	}
+}
@@ -0,0 +1,14 @@
+# Hosts seen on the IoT bridge, to be pinned before switching it to `reply-only`.
+# Static entries are listed too, so that pinned hosts stay in the table.
+data "mikrotik_arp_table" "iot" {
+  interface      = "iot"
+  include_static = true
+}
+
+resource "mikrotik_ip_arp" "iot" {
+  for_each = { for entry in data.mikrotik_arp_table.iot.entries : entry.address => entry if entry.complete }
+
+  address     = each.value.address
+  mac_address = each.value.mac_address
+  interface   = each.value.interface
+}
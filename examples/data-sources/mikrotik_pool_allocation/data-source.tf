@@ -0,0 +1,14 @@
+data "mikrotik_pool_allocation" "printers" {
+  pool = "dhcp-pool"
+  size = 2
+}
+
+resource "mikrotik_dhcp_lease" "printer" {
+  count      = 2
+  address    = data.mikrotik_pool_allocation.printers.addresses[count.index]
+  macaddress = var.printer_macs[count.index]
+
+  lifecycle {
+    ignore_changes = [address]
+  }
+}
@@ -0,0 +1,10 @@
+data "mikrotik_system_export" "firewall" {
+  path           = "/ip/firewall"
+  compact        = true
+  hide_sensitive = true
+}
+
+resource "local_file" "firewall" {
+  filename = "${path.module}/firewall.rsc"
+  content  = data.mikrotik_system_export.firewall.content
+}
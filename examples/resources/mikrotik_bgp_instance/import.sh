@@ -1,2 +1,2 @@
-# import with name of bgp instance
+# The name is resolved to the MikroTik internal id (e.g. *17), which can also be used directly.
 terraform import mikrotik_bgp_instance.instance bgp-instance-name
@@ -3,4 +3,7 @@
 #
 # [admin@MikroTik] /interface bridge> :put [ find where name=bridge-name]
 # *17
+terraform import mikrotik_bridge_interface.bridge '*17'
+
+# It can also be imported by name.
 terraform import mikrotik_bridge_interface.bridge bridge_name
@@ -1,6 +1,13 @@
 # The ID argument (*17) is a MikroTik's internal id.
 # It can be obtained via CLI:
 #
-# [admin@MikroTik] /interface bridge port> :put
-# *19
-terraform import mikrotik_bridge_interface_port.bridge_port <bridge_port_id>
+# [admin@MikroTik] /interface bridge port> :put [ find where interface=ether2]
+# *17
+terraform import mikrotik_bridge_interface_port.bridge_port '*17'
+
+# It can also be imported by interface name.
+terraform import mikrotik_bridge_interface_port.bridge_port ether2
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_bridge_interface_port.bridge_port 'bridge=bridge,interface=ether2'
@@ -0,0 +1,2 @@
+# Import with the name of the bridge; its static ports are managed by the resource
+terraform import mikrotik_bridge_ports.lan bridge-lan
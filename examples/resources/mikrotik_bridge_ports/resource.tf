@@ -0,0 +1,16 @@
+# Define Bridge Ports
+resource "mikrotik_bridge_ports" "lan" {
+
+  # Bridge Name
+  bridge = "bridge-lan"
+
+  # Interfaces : the ports of the bridge
+  interfaces = [
+    "ether2",
+    "ether3",
+    "ether4",
+  ]
+
+  # Ports Comment
+  comment = "managed by terraform"
+}
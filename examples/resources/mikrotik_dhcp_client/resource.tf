@@ -0,0 +1,8 @@
+resource "mikrotik_dhcp_client" "wan" {
+  interface              = "ether1"
+  add_default_route      = "yes"
+  default_route_distance = 1
+  use_peer_dns           = true
+  use_peer_ntp           = false
+  comment                = "Uplink"
+}
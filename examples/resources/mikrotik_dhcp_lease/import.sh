@@ -3,3 +3,7 @@
 # [admin@MikroTik] /ip dhcp-server lease> :put [find where address=10.0.1.254]
 # *19
 terraform import mikrotik_dhcp_lease.file_server '*19'
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_dhcp_lease.file_server 'mac=11:22:33:44:55:66'
@@ -4,3 +4,12 @@ resource "mikrotik_dhcp_lease" "file_server" {
   comment    = "file server"
   blocked    = "false"
 }
+
+# Pins the address a device already leased
+resource "mikrotik_dhcp_lease" "printer" {
+  address       = "192.168.88.20"
+  macaddress    = "66:55:44:33:22:11"
+  server        = "main-dhcp-server"
+  lease_time    = "1d"
+  adopt_dynamic = true
+}
@@ -0,0 +1,2 @@
+# Import with the name of the DHCP server; its static leases are managed by the resource
+terraform import mikrotik_dhcp_lease_table.lan dhcp-lan
@@ -0,0 +1,19 @@
+# Define DHCP Lease Table
+resource "mikrotik_dhcp_lease_table" "lan" {
+
+  # DHCP Server Name (`all` for leases given by any server)
+  server = "dhcp-lan"
+
+  # Static Leases : one block per host
+  lease {
+    macaddress = "02:00:00:00:00:01"
+    address    = "192.168.88.10"
+    comment    = "printer"
+  }
+
+  lease {
+    macaddress = "02:00:00:00:00:02"
+    address    = "192.168.88.11"
+    blocked    = true
+  }
+}
@@ -0,0 +1,18 @@
+resource "mikrotik_dhcp_option" "tftp_server" {
+  name      = "tftp-server"
+  code      = 66
+  ip_values = ["192.168.88.2"]
+}
+
+resource "mikrotik_dhcp_option" "boot_file" {
+  name         = "boot-file"
+  code         = 67
+  string_value = "pxelinux.0"
+}
+
+# Classless static route 10.0.0.0/8 via 192.168.88.254
+resource "mikrotik_dhcp_option" "classless_routes" {
+  name      = "classless-routes"
+  code      = 121
+  hex_value = "080ac0a858fe"
+}
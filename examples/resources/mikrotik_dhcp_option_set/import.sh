@@ -0,0 +1,2 @@
+# The name is resolved to the MikroTik internal id (e.g. *1), which can also be used directly.
+terraform import mikrotik_dhcp_option_set.pxe pxe
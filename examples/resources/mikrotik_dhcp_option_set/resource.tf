@@ -0,0 +1,10 @@
+resource "mikrotik_dhcp_option_set" "pxe" {
+  name    = "pxe"
+  options = [mikrotik_dhcp_option.tftp_server.name, mikrotik_dhcp_option.boot_file.name]
+}
+
+resource "mikrotik_dhcp_server_network" "lan" {
+  address         = "192.168.88.0/24"
+  gateway         = "192.168.88.1"
+  dhcp_option_set = mikrotik_dhcp_option_set.pxe.name
+}
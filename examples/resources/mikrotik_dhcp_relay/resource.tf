@@ -0,0 +1,6 @@
+resource "mikrotik_dhcp_relay" "branch" {
+  name          = "branch-relay"
+  interface     = "ether2"
+  dhcp_servers  = ["10.0.0.2", "10.0.0.3"]
+  local_address = "192.168.88.1"
+}
@@ -9,5 +9,6 @@ resource "mikrotik_dhcp_server" "default" {
   authoritative = "yes"
   disabled      = false
   interface     = "ether2"
+  lease_time    = "1h"
   name          = "main-dhcp-server"
 }
@@ -1 +1,5 @@
 terraform import mikrotik_dhcp_server_network.default <network-id>
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_dhcp_server_network.default 'address=192.168.100.0/24'
@@ -0,0 +1,15 @@
+# Prefix delegated by the ISP, handed out as /64 prefixes of the `isp-prefix` pool
+resource "mikrotik_dhcpv6_client" "wan" {
+  interface         = "ether1"
+  request           = ["prefix"]
+  pool_name         = "isp-prefix"
+  prefix_hint       = "::/56"
+  add_default_route = true
+}
+
+resource "mikrotik_ipv6_address" "lan" {
+  address   = "::1/64"
+  from_pool = mikrotik_dhcpv6_client.wan.pool_name
+  interface = "bridge"
+  advertise = true
+}
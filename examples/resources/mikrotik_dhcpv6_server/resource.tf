@@ -0,0 +1,12 @@
+resource "mikrotik_ipv6_pool" "customers" {
+  name          = "customers"
+  prefix        = "2001:db8:1000::/48"
+  prefix_length = 56
+}
+
+resource "mikrotik_dhcpv6_server" "customers" {
+  name         = "customers"
+  interface    = "ether2"
+  address_pool = mikrotik_ipv6_pool.customers.name
+  lease_time   = "1d"
+}
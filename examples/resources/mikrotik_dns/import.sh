@@ -0,0 +1,2 @@
+# The DNS settings are a singleton, any ID imports them.
+terraform import mikrotik_dns.dns dns
@@ -0,0 +1,6 @@
+resource "mikrotik_dns" "dns" {
+  servers               = ["2606:4700:4700::1111", "1.1.1.1"]
+  allow_remote_requests = true
+  cache_size            = 4096
+  cache_max_ttl         = "1d"
+}
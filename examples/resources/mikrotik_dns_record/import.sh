@@ -1 +1,2 @@
+# The name is resolved to the MikroTik internal id (e.g. *17), which can also be used directly.
 terraform import mikrotik_dns_record.record example.domain.com
@@ -3,3 +3,16 @@ resource "mikrotik_dns_record" "record" {
   address = "192.168.88.1"
   ttl     = 300
 }
+
+resource "mikrotik_dns_record" "mail" {
+  name          = "example.domain.com"
+  type          = "MX"
+  mx_exchange   = "mail.example.domain.com"
+  mx_preference = 10
+}
+
+resource "mikrotik_dns_record" "lab" {
+  regexp     = ".*\\.lab\\.domain\\.com"
+  type       = "FWD"
+  forward_to = "10.0.0.53"
+}
@@ -0,0 +1,2 @@
+# Import with the name of the address list; its static entries are managed by the resource
+terraform import mikrotik_firewall_address_list.trusted trusted
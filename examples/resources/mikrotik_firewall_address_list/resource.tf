@@ -0,0 +1,15 @@
+# Define Firewall Address List
+resource "mikrotik_firewall_address_list" "trusted" {
+
+  # Address List Name (referenced by firewall rules)
+  name = "trusted"
+
+  # Addresses : IP addresses, prefixes, ranges or DNS names
+  addresses = [
+    "10.0.0.0/24",
+    "192.168.88.10-192.168.88.20",
+  ]
+
+  # Entries Comment
+  comment = "managed by terraform"
+}
@@ -1 +1,5 @@
 terraform import mikrotik_firewall_raw.raw <raw-id>
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_firewall_raw.raw 'chain=prerouting,comment=drop-bogons'
@@ -1 +1,5 @@
 terraform import mikrotik_firewall_rule.rule <rule-id>
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_firewall_rule.rule 'chain=forward,comment=allow-web'
@@ -0,0 +1,10 @@
+# The ID argument (*3) is a MikroTik's internal id.
+# It can be obtained via CLI:
+#
+# [admin@MikroTik] /ip arp> :put [find where address="192.168.90.10"]
+# *3
+terraform import mikrotik_ip_arp.camera '*3'
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_ip_arp.camera 'address=192.168.90.10,interface=iot'
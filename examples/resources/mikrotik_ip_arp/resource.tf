@@ -0,0 +1,11 @@
+resource "mikrotik_bridge_interface" "iot" {
+  name = "iot"
+  arp  = "reply-only"
+}
+
+resource "mikrotik_ip_arp" "camera" {
+  address     = "192.168.90.10"
+  mac_address = "02:00:00:00:90:10"
+  interface   = mikrotik_bridge_interface.iot.name
+  comment     = "Camera"
+}
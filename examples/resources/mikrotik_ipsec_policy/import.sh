@@ -1 +1,5 @@
 terraform import mikrotik_ipsec_policy.identity <policy-id>
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_ipsec_policy.identity 'src_address=10.0.0.0/24,dst_address=10.1.0.0/24'
@@ -4,3 +4,7 @@
 # [admin@MikroTik] /ipv6 address> :put [find where address="192.168.88.1/24"]
 # *19
 terraform import mikrotik_ipv6_address.lan *19
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_ipv6_address.lan 'address=fd00::1/64'
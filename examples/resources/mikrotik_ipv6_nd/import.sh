@@ -0,0 +1,3 @@
+# The interface is resolved to the MikroTik internal id (e.g. *1), which can also be used directly.
+# The default entry is imported with `all`.
+terraform import mikrotik_ipv6_nd.lan bridge
@@ -0,0 +1,5 @@
+resource "mikrotik_ipv6_nd" "lan" {
+  interface     = "bridge"
+  ra_interval   = "30s-1m"
+  advertise_dns = true
+}
@@ -0,0 +1,5 @@
+resource "mikrotik_ipv6_pool" "customers" {
+  name          = "customers"
+  prefix        = "2001:db8:1000::/48"
+  prefix_length = 56
+}
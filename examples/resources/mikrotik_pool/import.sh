@@ -4,3 +4,7 @@
 # [admin@MikroTik] /ip pool> :put [ find where name=pool-name]
 # *17
 terraform import mikrotik_pool.pool '*17'
+
+# It can also be imported by natural key, i.e. comma separated `property=value`
+# pairs (MikroTik property or attribute names) matching a single item.
+terraform import mikrotik_pool.pool 'name=pool-name'
@@ -0,0 +1,2 @@
+# The ID argument is the backup name (the file is named `<name>.backup`).
+terraform import mikrotik_system_backup.before_change before-terraform
@@ -0,0 +1,9 @@
+resource "mikrotik_system_backup" "before_change" {
+  name     = "before-terraform"
+  password = var.backup_password
+
+  # Save the backup again whenever the firewall changes
+  triggers = {
+    firewall = sha1(jsonencode(mikrotik_firewall_rule.input_accept))
+  }
+}
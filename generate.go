@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik"
+)
+
+/**
+ * Function used to run the `generate` Subcommand (HCL generation from an existing router)
+ */
+func generate(args []string) error {
+
+	// Define Subcommand Flags (unset ones fall back on `MIKROTIK_*` Variables and the Credentials Profile, like the Provider)
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	flags.String("host", "", "hostname of the MikroTik router")
+	flags.Int("port", 0, "API port of the router")
+	flags.String("username", "", "user account for MikroTik api")
+	flags.String("password", "", "password for MikroTik api")
+	flags.String("password-file", "", "path to a file holding the password for MikroTik api")
+	flags.String("profile", "", "profile of the credentials file filling the settings left empty")
+	flags.Bool("tls", false, "whether to use TLS when connecting to MikroTik")
+	flags.String("ca-certificate", "", "path to MikroTik's certificate authority")
+	flags.Bool("insecure", false, "do not verify MikroTik's TLS certificate")
+	exportFile := flags.String("export-file", "", "read the configuration from an `/export` file instead of connecting to the router")
+	output := flags.String("output", "", "file to write the generated configuration to (default: stdout)")
+	flags.Parse(args)
+
+	// Keep the Settings of the Flags that were set
+	settings := map[string]interface{}{}
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name != "export-file" && f.Name != "output" {
+			settings[strings.ReplaceAll(f.Name, "-", "_")] = f.Value.(flag.Getter).Get()
+		}
+	})
+
+	// Build Generator Source
+	source := mikrotik.GeneratorSource{}
+
+	// If an Export File is given
+	if *exportFile != "" {
+
+		// Open Export File
+		file, err := os.Open(*exportFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		// Parse Export
+		source.Export, err = client.ParseExport(file)
+		if err != nil {
+			return err
+		}
+
+	} else {
+
+		// Build Router Client
+		c, err := mikrotik.GeneratorClient(settings)
+		if err != nil {
+			return err
+		}
+
+		// A router is needed without export file
+		if c.Host == "" {
+			return fmt.Errorf("either -host (or MIKROTIK_HOST, or a profile host) or -export-file must be set")
+		}
+
+		source.Client = c
+	}
+
+	// Select Output
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	// Generate Configuration
+	return mikrotik.GenerateConfiguration(source, w)
+}
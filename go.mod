@@ -3,9 +3,9 @@ module github.com/kube-cloud/terraform-provider-mikrotik
 go 1.16
 
 require (
+	github.com/go-routeros/routeros v0.0.0-20210123142807-2a44d57c6730
 	github.com/hashicorp/terraform-plugin-docs v0.13.0
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.20.0
-	github.com/joho/godotenv v1.5.1 // indirect
 	github.com/kube-cloud/terraform-provider-mikrotik/client v0.0.0-00010101000000-000000000000
 )
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik"
@@ -13,6 +14,13 @@ import (
 //
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
@@ -31,4 +39,10 @@ func main() {
 			ProviderFunc: mikrotik.NewProvider,
 		})
 	}
+
+	// Terraform stops the plugin once the apply is done: remove the backups the
+	// connectivity guard left on the routers (their rollback is already cancelled).
+	if err := mikrotik.RemoveConnectivityGuards(); err != nil {
+		log.Println(err.Error())
+	}
 }
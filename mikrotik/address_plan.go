@@ -0,0 +1,294 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+// Modes of the address plan checks of addresses, pools and DHCP networks
+const (
+	conflictCheckOff   = "off"
+	conflictCheckWarn  = "warn"
+	conflictCheckError = "error"
+)
+
+// addressPlanCheck lists the conflicts of the planned object (read with get) with the router's addresses, pools and networks
+type addressPlanCheck func(c *client.Mikrotik, id string, get func(key string) interface{}) ([]string, error)
+
+// conflictCheckSchema is the attribute enabling an address plan check on a resource
+func conflictCheckSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          conflictCheckOff,
+		ValidateDiagFunc: validateEnum(conflictCheckOff, conflictCheckWarn, conflictCheckError),
+		Description:      "Whether to check the router's addresses, pools and DHCP networks for conflicts: `off`, `warn` (reported as warnings on apply, only logged on plan as plans cannot show warnings) or `error` (failing the plan, or the apply for conflicts with objects created in the same apply).",
+	}
+}
+
+// addressPlanCustomizeDiff runs the check on plan, failing it when `conflict_check` is `error`.
+// A plan cannot show warnings: conflicts in `warn` mode are only logged.
+func addressPlanCustomizeDiff(check addressPlanCheck, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		mode := d.Get("conflict_check").(string)
+		if mode == conflictCheckOff || mode == "" {
+			return nil
+		}
+
+		for _, key := range keys {
+			if !d.NewValueKnown(key) {
+				return nil
+			}
+		}
+
+		conflicts, err := check(m.(*client.Mikrotik), d.Id(), d.Get)
+		if err != nil {
+			return err
+		}
+		if len(conflicts) == 0 {
+			return nil
+		}
+
+		if mode == conflictCheckError {
+			return fmt.Errorf("address plan conflicts: %s", strings.Join(conflicts, "; "))
+		}
+		for _, conflict := range conflicts {
+			log.Printf("[WARN] address plan conflict: %s", conflict)
+		}
+
+		return nil
+	}
+}
+
+// addressPlanApplyCheck runs the check again right before the object is written, catching conflicts with
+// objects created earlier in the same apply: they fail the apply when `conflict_check` is `error`,
+// and are reported as warnings when it is `warn`
+func addressPlanApplyCheck(c *client.Mikrotik, d *schema.ResourceData, check addressPlanCheck) diag.Diagnostics {
+	mode := d.Get("conflict_check").(string)
+	if mode != conflictCheckWarn && mode != conflictCheckError {
+		return nil
+	}
+
+	conflicts, err := check(c, d.Id(), d.Get)
+	if err != nil && mode == conflictCheckError {
+		return diag.FromErr(fmt.Errorf("cannot check the address plan: %w", err))
+	}
+	if err != nil {
+		return diag.Diagnostics{{Severity: diag.Warning, Summary: "address plan not checked", Detail: err.Error()}}
+	}
+
+	if mode == conflictCheckError && len(conflicts) > 0 {
+		return diag.FromErr(fmt.Errorf("address plan conflicts: %s", strings.Join(conflicts, "; ")))
+	}
+
+	var diags diag.Diagnostics
+	for _, conflict := range conflicts {
+		diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "address plan conflict", Detail: conflict})
+	}
+
+	return diags
+}
+
+// checkIpAddressPlan checks an interface address against the router's addresses
+func checkIpAddressPlan(c *client.Mikrotik, id string, get func(key string) interface{}) ([]string, error) {
+	addresses, err := c.ListIpAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	return ipAddressConflicts(id, get("address").(string), get("interface").(string), addresses), nil
+}
+
+// checkPoolPlan checks the ranges of a pool against the router's pools, addresses and the networks of the DHCP servers using it
+func checkPoolPlan(c *client.Mikrotik, id string, get func(key string) interface{}) ([]string, error) {
+	pools, err := c.ListPools()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := c.ListIpAddress()
+	if err != nil {
+		return nil, err
+	}
+	servers, err := c.ListDhcpServers()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := client.Pool{Id: id, Name: get("name").(string), Ranges: get("ranges").(string)}
+
+	return poolConflicts(pool, pools, addresses, servers), nil
+}
+
+// checkDhcpServerNetworkPlan checks a DHCP network against its gateway and netmask, the router's DHCP networks and addresses
+func checkDhcpServerNetworkPlan(c *client.Mikrotik, id string, get func(key string) interface{}) ([]string, error) {
+	networks, err := c.ListDhcpServerNetworks()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := c.ListIpAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	network := client.DhcpServerNetwork{
+		Id:      id,
+		Address: get("address").(string),
+		Gateway: get("gateway").(string),
+		Netmask: get("netmask").(string),
+	}
+
+	return dhcpServerNetworkConflicts(network, networks, addresses), nil
+}
+
+// ipAddressConflicts reports the addresses overlapping address on other interfaces, or with another netmask on the same one
+func ipAddressConflicts(id, address, iface string, addresses []client.IpAddress) []string {
+	ip, network, err := net.ParseCIDR(address)
+	if err != nil {
+		return nil
+	}
+
+	conflicts := []string{}
+	for _, other := range addresses {
+		otherIp, otherNetwork, err := net.ParseCIDR(other.Address)
+		if other.Id == id || err != nil || !networksOverlap(network, otherNetwork) {
+			continue
+		}
+
+		switch {
+		case other.Interface != iface:
+			conflicts = append(conflicts, fmt.Sprintf("%s overlaps the address %s of interface `%s`", address, other.Address, other.Interface))
+		case otherIp.Equal(ip):
+			conflicts = append(conflicts, fmt.Sprintf("%s is already assigned to interface `%s`", ip, iface))
+		case network.String() != otherNetwork.String():
+			conflicts = append(conflicts, fmt.Sprintf("the netmask of %s differs from the one of %s on interface `%s`", address, other.Address, iface))
+		}
+	}
+
+	return conflicts
+}
+
+// poolConflicts reports the ranges of pool overlapping other pools or router addresses, or outside the networks of the DHCP servers using it
+func poolConflicts(pool client.Pool, pools []client.Pool, addresses []client.IpAddress, servers []client.DhcpServer) []string {
+	ranges, err := pool.ParseRanges()
+	if err != nil {
+		return nil
+	}
+
+	conflicts := []string{}
+	for _, other := range pools {
+		if other.Id == pool.Id || other.Name == pool.Name {
+			continue
+		}
+		otherRanges, err := other.ParseRanges()
+		if err != nil {
+			continue
+		}
+		for _, r := range ranges {
+			for _, o := range otherRanges {
+				if r.Overlaps(o) {
+					conflicts = append(conflicts, fmt.Sprintf("range %s overlaps the range %s of pool `%s`", formatPoolRange(r), formatPoolRange(o), other.Name))
+				}
+			}
+		}
+	}
+
+	for _, address := range addresses {
+		ip, _, err := net.ParseCIDR(address.Address)
+		if err != nil {
+			continue
+		}
+		for _, r := range ranges {
+			if r.Contains(ip) {
+				conflicts = append(conflicts, fmt.Sprintf("range %s includes the address %s of interface `%s`", formatPoolRange(r), ip, address.Interface))
+			}
+		}
+	}
+
+	for _, server := range servers {
+		if server.AddressPool != pool.Name {
+			continue
+		}
+
+		networks := []*net.IPNet{}
+		for _, address := range addresses {
+			if _, network, err := net.ParseCIDR(address.Address); err == nil && address.Interface == server.Interface {
+				networks = append(networks, network)
+			}
+		}
+		if len(networks) == 0 {
+			continue
+		}
+
+		for _, r := range ranges {
+			inside := false
+			for _, network := range networks {
+				inside = inside || (network.Contains(r.First) && network.Contains(r.Last))
+			}
+			if !inside {
+				conflicts = append(conflicts, fmt.Sprintf("range %s is outside the networks of interface `%s` served by DHCP server `%s`", formatPoolRange(r), server.Interface, server.Name))
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// dhcpServerNetworkConflicts reports gateways outside the network, a netmask differing from its prefix,
+// and overlaps with other DHCP networks or router addresses of another netmask
+func dhcpServerNetworkConflicts(network client.DhcpServerNetwork, networks []client.DhcpServerNetwork, addresses []client.IpAddress) []string {
+	_, prefix, err := net.ParseCIDR(network.Address)
+	if err != nil {
+		return nil
+	}
+	ones, _ := prefix.Mask.Size()
+
+	conflicts := []string{}
+	for _, gateway := range strings.Split(network.Gateway, ",") {
+		ip := net.ParseIP(strings.TrimSpace(gateway))
+		if ip != nil && !ip.IsUnspecified() && !prefix.Contains(ip) {
+			conflicts = append(conflicts, fmt.Sprintf("gateway %s is outside the network %s", ip, prefix))
+		}
+	}
+
+	if netmask, err := strconv.Atoi(network.Netmask); err == nil && netmask != 0 && netmask != ones {
+		conflicts = append(conflicts, fmt.Sprintf("netmask %d differs from the prefix length of the network %s", netmask, prefix))
+	}
+
+	for _, other := range networks {
+		_, otherPrefix, err := net.ParseCIDR(other.Address)
+		if other.Id == network.Id || err != nil || !networksOverlap(prefix, otherPrefix) {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("network %s overlaps the DHCP network %s", prefix, otherPrefix))
+	}
+
+	for _, address := range addresses {
+		_, addressPrefix, err := net.ParseCIDR(address.Address)
+		if err != nil || !networksOverlap(prefix, addressPrefix) || addressPrefix.String() == prefix.String() {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("network %s does not match the address %s of interface `%s`", prefix, address.Address, address.Interface))
+	}
+
+	return conflicts
+}
+
+// networksOverlap tells whether two networks share addresses, i.e. one contains the other
+func networksOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func formatPoolRange(r client.PoolRange) string {
+	if r.First.Equal(r.Last) {
+		return r.First.String()
+	}
+	return r.First.String() + "-" + r.Last.String()
+}
@@ -0,0 +1,100 @@
+package mikrotik
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+var addressPlanAddresses = []client.IpAddress{
+	{Id: "*1", Address: "10.0.0.1/24", Interface: "lan"},
+	{Id: "*2", Address: "10.1.0.1/16", Interface: "wan"},
+}
+
+func TestIpAddressConflicts(t *testing.T) {
+	cases := []struct {
+		id, address, iface string
+		expected           []string
+	}{
+		{"", "10.2.0.1/24", "dmz", []string{}},
+		{"", "10.0.0.2/24", "lan", []string{}},
+		{"*1", "10.0.0.1/25", "lan", []string{}},
+		{"", "10.1.5.1/24", "dmz", []string{"10.1.5.1/24 overlaps the address 10.1.0.1/16 of interface `wan`"}},
+		{"", "10.0.0.1/24", "lan", []string{"10.0.0.1 is already assigned to interface `lan`"}},
+		{"", "10.0.0.129/25", "lan", []string{"the netmask of 10.0.0.129/25 differs from the one of 10.0.0.1/24 on interface `lan`"}},
+	}
+
+	for _, tc := range cases {
+		if actual := ipAddressConflicts(tc.id, tc.address, tc.iface, addressPlanAddresses); !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("unexpected conflicts of %s on %s: %v, expected %v", tc.address, tc.iface, actual, tc.expected)
+		}
+	}
+}
+
+func TestPoolConflicts(t *testing.T) {
+	pools := []client.Pool{
+		{Id: "*1", Name: "lan", Ranges: "10.0.0.100-10.0.0.199"},
+		{Id: "*2", Name: "guest", Ranges: "10.0.0.150-10.0.0.160"},
+	}
+	servers := []client.DhcpServer{{Name: "dhcp-lan", Interface: "lan", AddressPool: "lan"}}
+
+	pool := client.Pool{Id: "*1", Name: "lan", Ranges: "10.0.0.1-10.0.0.10,10.0.0.150,10.0.1.10-10.0.1.20"}
+	expected := []string{
+		"range 10.0.0.150 overlaps the range 10.0.0.150-10.0.0.160 of pool `guest`",
+		"range 10.0.0.1-10.0.0.10 includes the address 10.0.0.1 of interface `lan`",
+		"range 10.0.1.10-10.0.1.20 is outside the networks of interface `lan` served by DHCP server `dhcp-lan`",
+	}
+	if actual := poolConflicts(pool, pools, addressPlanAddresses, servers); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("The conflicts do not match what we expected. actual: %v expected: %v", actual, expected)
+	}
+
+	if actual := poolConflicts(pools[0], pools[:1], addressPlanAddresses, servers); len(actual) != 0 {
+		t.Errorf("expected no conflicts, got %v", actual)
+	}
+}
+
+func TestDhcpServerNetworkConflicts(t *testing.T) {
+	networks := []client.DhcpServerNetwork{
+		{Id: "*1", Address: "10.0.0.0/24"},
+		{Id: "*2", Address: "10.1.0.0/16"},
+	}
+
+	network := client.DhcpServerNetwork{Id: "*1", Address: "10.0.0.0/24", Gateway: "10.0.0.1", Netmask: "0"}
+	if actual := dhcpServerNetworkConflicts(network, networks, addressPlanAddresses); len(actual) != 0 {
+		t.Errorf("expected no conflicts, got %v", actual)
+	}
+
+	network = client.DhcpServerNetwork{Address: "10.1.2.0/24", Gateway: "10.1.3.1,0.0.0.0", Netmask: "16"}
+	expected := []string{
+		"gateway 10.1.3.1 is outside the network 10.1.2.0/24",
+		"netmask 16 differs from the prefix length of the network 10.1.2.0/24",
+		"network 10.1.2.0/24 overlaps the DHCP network 10.1.0.0/16",
+		"network 10.1.2.0/24 does not match the address 10.1.0.1/16 of interface `wan`",
+	}
+	if actual := dhcpServerNetworkConflicts(network, networks, addressPlanAddresses); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("The conflicts do not match what we expected. actual: %v expected: %v", actual, expected)
+	}
+}
+
+func TestAddressPlanApplyCheck(t *testing.T) {
+	check := func(c *client.Mikrotik, id string, get func(key string) interface{}) ([]string, error) {
+		return []string{"10.0.0.1 is already assigned to interface `lan`"}, nil
+	}
+
+	for mode, expected := range map[string]diag.Severity{conflictCheckWarn: diag.Warning, conflictCheckError: diag.Error} {
+		d := resourceIpAddress().TestResourceData()
+		d.Set("conflict_check", mode)
+
+		diags := addressPlanApplyCheck(nil, d, check)
+		if len(diags) != 1 || diags[0].Severity != expected {
+			t.Errorf("unexpected diagnostics in `%s` mode: %v", mode, diags)
+		}
+	}
+
+	d := resourceIpAddress().TestResourceData()
+	if diags := addressPlanApplyCheck(nil, d, check); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics with the check off: %v", diags)
+	}
+}
@@ -0,0 +1,201 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Define ARP Table Data Source: the Entries learnt in the ARP Table (or in the IPv6 Neighbor Table)
+ */
+func dataSourceArpTable() *schema.Resource {
+
+	// Build and Return Data Source
+	return &schema.Resource{
+
+		// Data Source Description
+		Description: "Lists the entries of the ARP table learnt by the router (`/ip/arp`), or of the IPv6 neighbor table (`/ipv6/neighbor`), e.g. to turn the hosts of a network into static `mikrotik_ip_arp` entries before switching its interface to `reply-only`. IPv6 neighbors cannot be made static on RouterOS.",
+
+		// Read Data Source Context Method CallBack
+		ReadContext: readArpTable,
+
+		// Define Data Source Schema
+		Schema: map[string]*schema.Schema{
+			"family": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ipv4",
+				ValidateDiagFunc: validateEnum("ipv4", "ipv6"),
+				Description:      "Table to read: `ipv4` for the ARP table, `ipv6` for the neighbor table.",
+			},
+			"interface": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOptionalName,
+				Description:      "Only list the entries of this interface.",
+			},
+			"include_static": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether static ARP entries are listed too. Only dynamic entries are listed otherwise.",
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the entry.",
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "MAC address the IP address resolves to, empty while unresolved.",
+						},
+						"interface": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Interface the host is reached through.",
+						},
+						"dynamic": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the entry was learnt by the router.",
+						},
+						"published": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the router answers ARP requests for the address. Always false for IPv6.",
+						},
+						"complete": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the address is resolved.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the entry (e.g. `reachable` or `stale`) where RouterOS reports one.",
+						},
+					},
+				},
+				Description: "Entries of the table.",
+			},
+		},
+	}
+}
+
+/**
+ * Function used to Read ARP Table
+ */
+func readArpTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Client
+	c := m.(*client.Mikrotik)
+
+	// Read Entries of the requested Family
+	var entries []map[string]interface{}
+	var err error
+	if d.Get("family").(string) == "ipv6" {
+		entries, err = readIpv6NeighborEntries(c)
+	} else {
+		entries, err = readIpArpEntries(c, d.Get("include_static").(bool))
+	}
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Filter Entries on Interface
+	iface := d.Get("interface").(string)
+	filtered := []map[string]interface{}{}
+	for _, entry := range entries {
+		if iface == "" || entry["interface"] == iface {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	// Set ID (the Family, and Interface if any) and Entries
+	d.SetId(d.Get("family").(string) + "/" + iface)
+	d.Set("entries", filtered)
+
+	// Return Diagnostic
+	return nil
+}
+
+/**
+ * Function used to Read ARP Entries
+ */
+func readIpArpEntries(c *client.Mikrotik, includeStatic bool) ([]map[string]interface{}, error) {
+
+	// List ARP Entries
+	records, err := c.ListIpArp()
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Convert Entries
+	entries := []map[string]interface{}{}
+	for _, r := range records {
+		if r.Dynamic || includeStatic {
+			entries = append(entries, map[string]interface{}{
+				"address":     r.Address,
+				"mac_address": r.MacAddress,
+				"interface":   r.Interface,
+				"dynamic":     r.Dynamic,
+				"published":   r.Published,
+				"complete":    r.Complete,
+				"status":      r.Status,
+			})
+		}
+	}
+
+	// Return Entries
+	return entries, nil
+}
+
+/**
+ * Function used to Read IPv6 Neighbor Entries
+ */
+func readIpv6NeighborEntries(c *client.Mikrotik) ([]map[string]interface{}, error) {
+
+	// List Neighbors
+	records, err := c.ListIpv6Neighbors()
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return nil, err
+	}
+
+	// Convert Entries
+	entries := []map[string]interface{}{}
+	for _, r := range records {
+		entries = append(entries, map[string]interface{}{
+			"address":     r.Address,
+			"mac_address": r.MacAddress,
+			"interface":   r.Interface,
+			"dynamic":     true,
+			"published":   false,
+			"complete":    r.MacAddress != "",
+			"status":      r.Status,
+		})
+	}
+
+	// Return Entries
+	return entries, nil
+}
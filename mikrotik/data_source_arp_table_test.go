@@ -0,0 +1,72 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/**
+ * ARP Table Data Source Read Test
+ */
+func TestArpTable_Read(t *testing.T) {
+
+	// Initialize Interface Name
+	name := acctest.RandomWithPrefix("tf-acc-arp-table")
+
+	// Initialize Data Source Name
+	dataSourceName := "data.mikrotik_arp_table.testacc"
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Interface, Static Entry and Data Source
+				Config: fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "testacc" {
+	name = %[1]q
+}
+
+resource "mikrotik_ip_arp" "testacc" {
+	address     = "10.213.0.10"
+	mac_address = "02:00:00:21:30:10"
+	interface   = mikrotik_bridge_interface.testacc.name
+}
+
+data "mikrotik_arp_table" "testacc" {
+	interface      = mikrotik_bridge_interface.testacc.name
+	include_static = true
+
+	depends_on = [mikrotik_ip_arp.testacc]
+}
+
+data "mikrotik_arp_table" "dynamic" {
+	interface = mikrotik_bridge_interface.testacc.name
+
+	depends_on = [mikrotik_ip_arp.testacc]
+}
+`, name),
+
+				// Check Test Data Source
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "ipv4/"+name),
+					resource.TestCheckResourceAttr(dataSourceName, "entries.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "entries.0.address", "10.213.0.10"),
+					resource.TestCheckResourceAttr(dataSourceName, "entries.0.mac_address", "02:00:00:21:30:10"),
+					resource.TestCheckResourceAttr(dataSourceName, "entries.0.dynamic", "false"),
+					resource.TestCheckResourceAttr("data.mikrotik_arp_table.dynamic", "entries.#", "0"),
+				),
+			},
+		},
+	})
+}
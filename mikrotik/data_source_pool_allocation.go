@@ -0,0 +1,143 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Define Pool Allocation Data Source: the next free Addresses of an IP Pool and of the Pools chained to it by `next-pool`
+ */
+func dataSourcePoolAllocation() *schema.Resource {
+
+	// Build and Return Data Source
+	return &schema.Resource{
+
+		// Data Source Description
+		Description: "Finds the next free addresses of an IP pool, and of the pools chained to it by `next-pool`, to assign static leases and addresses without collisions. Addresses in use (`/ip/pool/used`) and those of static DHCP leases are skipped. The addresses are computed again on each refresh, so resources using them should ignore later changes (`lifecycle { ignore_changes = [address] }`).",
+
+		// Read Data Source Context Method CallBack
+		ReadContext: readPoolAllocation,
+
+		// Define Data Source Schema
+		Schema: map[string]*schema.Schema{
+			"pool": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the IP pool.",
+			},
+			"size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Number of free addresses to find. Reading fails when the pools have fewer.",
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateIpv4Address,
+				},
+				Description: "Addresses to skip as well (e.g. planned elsewhere).",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Free addresses found, in the order of the pool ranges.",
+			},
+			"ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pool": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the pool of the range.",
+						},
+						"first": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "First address of the range.",
+						},
+						"last": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last address of the range.",
+						},
+					},
+				},
+				Description: "Ranges of the pool then of the pools chained to it.",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of addresses of the ranges.",
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of addresses of the ranges in use, statically leased or excluded.",
+			},
+			"free": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of addresses of the ranges left.",
+			},
+		},
+	}
+}
+
+/**
+ * Function used to Read Pool Allocation
+ */
+func readPoolAllocation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Pool Client
+	c := m.(*client.Mikrotik)
+
+	// Get Excluded Addresses
+	exclude := []string{}
+	for _, address := range d.Get("exclude").([]interface{}) {
+		exclude = append(exclude, address.(string))
+	}
+
+	// Allocate Addresses
+	allocation, err := c.AllocatePool(d.Get("pool").(string), d.Get("size").(int), exclude)
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Convert Ranges
+	ranges := []map[string]interface{}{}
+	for _, r := range allocation.Ranges {
+		ranges = append(ranges, map[string]interface{}{
+			"pool":  r.Pool,
+			"first": r.First.String(),
+			"last":  r.Last.String(),
+		})
+	}
+
+	// Set ID (the Pool Name) and Allocation
+	d.SetId(d.Get("pool").(string))
+	d.Set("addresses", allocation.Free)
+	d.Set("ranges", ranges)
+	d.Set("total", allocation.Total)
+	d.Set("used", allocation.Used)
+	d.Set("free", allocation.Total-allocation.Used)
+
+	// Return Diagnostic
+	return nil
+}
@@ -0,0 +1,75 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/**
+ * Pool Allocation Data Source Read Test
+ */
+func TestPoolAllocation_Read(t *testing.T) {
+
+	// Initialize Pool Name
+	name := acctest.RandomWithPrefix("tf-acc-pool-allocation")
+
+	// Initialize Data Source Name
+	dataSourceName := "data.mikrotik_pool_allocation.testacc"
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Pools, Lease and Data Source
+				Config: fmt.Sprintf(`
+resource "mikrotik_pool" "overflow" {
+	name   = "%[1]s-overflow"
+	ranges = "10.211.1.1-10.211.1.4"
+}
+
+resource "mikrotik_pool" "testacc" {
+	name      = %[1]q
+	ranges    = "10.211.0.1-10.211.0.3"
+	next_pool = mikrotik_pool.overflow.name
+}
+
+resource "mikrotik_dhcp_lease" "testacc" {
+	address    = "10.211.0.2"
+	macaddress = "02:00:00:21:10:02"
+}
+
+data "mikrotik_pool_allocation" "testacc" {
+	pool    = mikrotik_pool.testacc.name
+	size    = 3
+	exclude = ["10.211.0.3"]
+
+	depends_on = [mikrotik_dhcp_lease.testacc]
+}
+`, name),
+
+				// Check Test Data Source
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", name),
+					resource.TestCheckResourceAttr(dataSourceName, "addresses.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "addresses.0", "10.211.0.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "addresses.1", "10.211.1.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ranges.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "total", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "used", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "free", "5"),
+				),
+			},
+		},
+	})
+}
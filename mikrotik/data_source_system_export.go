@@ -0,0 +1,97 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Define System Export Data Source: the `/export` Script of the whole Configuration or of one Menu
+ */
+func dataSourceSystemExport() *schema.Resource {
+
+	// Build and Return Data Source
+	return &schema.Resource{
+
+		// Data Source Description
+		Description: "Reads the configuration of a MikroTik device, or of one of its menus, as `/export` script text. Exports larger than 4 KiB are read in chunks, which needs RouterOS 7. The export flags of RouterOS 6 and 7 are chosen from the router version.",
+
+		// Read Data Source Context Method CallBack
+		ReadContext: readSystemExport,
+
+		// Define Data Source Schema
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateString(checkMenuPath),
+				Description:      "Menu to export (eg. `/ip/firewall`). The whole configuration is exported when empty.",
+			},
+			"compact": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"verbose"},
+				Description:   "Export only the values changed from their defaults. RouterOS 7 always exports them so.",
+			},
+			"verbose": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"compact"},
+				Description:   "Export every value, the defaults included. The plain export is used when neither `compact` nor `verbose` is set.",
+			},
+			"hide_sensitive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Hide passwords, keys and other sensitive values.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Export Script Text. Holds passwords and keys unless `hide_sensitive` is set.",
+			},
+		},
+	}
+}
+
+/**
+ * Function used to Read System Export
+ */
+func readSystemExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Define Diagnostic variable
+	var diags diag.Diagnostics
+
+	// Get System Export Client
+	c := m.(*client.Mikrotik)
+
+	// Export Configuration
+	content, err := c.SystemExport(client.SystemExportOptions{
+		Path:          d.Get("path").(string),
+		Compact:       d.Get("compact").(bool),
+		Verbose:       d.Get("verbose").(bool),
+		HideSensitive: d.Get("hide_sensitive").(bool),
+	})
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Set ID (the exported Menu) and Content
+	d.SetId(fmt.Sprintf("%s/export", d.Get("path").(string)))
+	d.Set("content", content)
+
+	// Return Diagnistic
+	return diags
+}
@@ -0,0 +1,47 @@
+package mikrotik
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/**
+ * System Export Data Source Read Test
+ */
+func TestSystemExport_Read(t *testing.T) {
+
+	// Initialize Data Source Name
+	dataSourceName := "data.mikrotik_system_export.testacc"
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Data Source
+				Config: `
+data "mikrotik_system_export" "testacc" {
+	path           = "/ip/pool"
+	compact        = true
+	hide_sensitive = true
+}
+`,
+
+				// Check Test Data Source
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "/ip/pool/export"),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`/ip pool`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,486 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Function used to build the Schema of the Provider `devices` Block
+ */
+func devicesSchema() *schema.Schema {
+
+	// Build and Return Schema
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Additional routers managed by this provider configuration, targeted with the `device` attribute of resources and data sources. Username, password (or password file), CA and client certificates and minimum TLS version default to the provider's",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validateName,
+					Description:      "Name of the device, referenced by the `device` attribute",
+				},
+				"host": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Hostname of the MikroTik router",
+				},
+				"port": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          0,
+					ValidateDiagFunc: validatePort,
+					Description:      "API port of the router, 8728 (or 8729 with `tls`) when neither set here nor in `host`",
+				},
+				"username": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "User account for MikroTik api",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password for MikroTik api",
+				},
+				"password_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to a file holding the password for MikroTik api, used when `password` is empty",
+				},
+				"tls": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether to use TLS when connecting to MikroTik or not",
+				},
+				"ca_certificate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to MikroTik's certificate authority",
+				},
+				"insecure": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Insecure connection does not verify MikroTik's TLS certificate",
+				},
+				"ca_certificate_pem": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "PEM encoded certificate authority, trusted along with `ca_certificate`",
+				},
+				"client_certificate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to, or PEM contents of, the client certificate presented to the router",
+				},
+				"client_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Path to, or PEM contents of, the private key of `client_certificate`",
+				},
+				"tls_server_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name the router certificate is verified against, when it differs from `host`",
+				},
+				"tls_min_version": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateEnum("1.0", "1.1", "1.2", "1.3"),
+					Description:      "Lowest TLS version accepted from the router (1.0, 1.1, 1.2 or 1.3)",
+				},
+			},
+		},
+	}
+}
+
+/**
+ * Function used to build the Clients of the default Device and of every `devices` Block
+ */
+func configureDevices(d *schema.ResourceData, configure func(c *client.Mikrotik) error) (*client.Devices, error) {
+
+	// Initialize Devices
+	devices := client.NewDevices()
+
+	// Get Provider Settings (shared by the Devices that do not set theirs)
+	provider := map[string]interface{}{}
+	for _, setting := range deviceSettings {
+		provider[setting] = d.Get(setting)
+	}
+	provider["host"] = d.Get("host")
+	provider["password_file"] = d.Get("password_file")
+
+	// Get Settings set in Configuration or Environment (a Boolean set to false is not empty)
+	explicit := map[string]bool{}
+	for _, setting := range deviceSettings {
+		_, explicit[setting] = d.GetOkExists(setting)
+	}
+
+	// Fill empty Settings from the Credentials Profile
+	if err := applyProfile(d.Get("profile").(string), provider, explicit); err != nil {
+		return nil, err
+	}
+
+	// If Provider has its own Router
+	if host := provider["host"].(string); host != "" {
+
+		// Register default Device
+		c, err := deviceToClient(host, provider, provider)
+		if err == nil {
+			err = configure(c)
+		}
+		if err != nil {
+			return nil, err
+		}
+		devices.Add("", c)
+	}
+
+	// For each Device Block
+	for _, raw := range d.Get("devices").([]interface{}) {
+		device := raw.(map[string]interface{})
+
+		// Build Device Client
+		c, err := deviceToClient(device["host"].(string), device, provider)
+		if err == nil {
+			err = configure(c)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("device `%s`: %w", device["name"], err)
+		}
+
+		// Register Device
+		if err := devices.Add(device["name"].(string), c); err != nil {
+			return nil, err
+		}
+	}
+
+	// Return Devices
+	return devices, nil
+}
+
+/**
+ * Function used to fill the Provider Settings left empty from a Credentials Profile, and to resolve
+ * the Password: `password`, else `password_file`, else the Profile's. Booleans are empty unless explicit.
+ */
+func applyProfile(name string, provider map[string]interface{}, explicit map[string]bool) error {
+
+	// Load Profile
+	profile, err := client.LoadProfile(name)
+
+	// If There is Error
+	if err != nil {
+
+		// Return Error
+		return err
+	}
+
+	// Resolve Password
+	if provider["password"].(string) == "" {
+		password, err := devicePassword(provider["password_file"].(string))
+		if err == nil && password == "" {
+			password, err = profile.Password()
+		}
+		if err != nil {
+			return err
+		}
+		provider["password"] = password
+	}
+
+	// For each Setting left empty
+	for setting, value := range provider {
+		switch value := value.(type) {
+		case string:
+			if value == "" && setting != "password" && setting != "password_file" {
+				provider[setting] = profile.Get(setting)
+			}
+		case bool:
+			if !explicit[setting] && profile.Get(setting) != "" {
+				enabled, err := strconv.ParseBool(profile.Get(setting))
+				if err != nil {
+					return fmt.Errorf("profile `%s`: %s: %w", profile.Name, setting, err)
+				}
+				provider[setting] = enabled
+			}
+		case int:
+			if value == 0 && profile.Get(setting) != "" {
+				port, err := strconv.Atoi(profile.Get(setting))
+				if err != nil {
+					return fmt.Errorf("profile `%s`: %s: %w", profile.Name, setting, err)
+				}
+				provider[setting] = port
+			}
+		}
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Read the Password File of a Device ("" when no File is set)
+ */
+func devicePassword(file string) (string, error) {
+
+	// If no File is set
+	if file == "" {
+		return "", nil
+	}
+
+	// Read File
+	return client.ReadPasswordFile(file)
+}
+
+// deviceSettings lists the Settings of the Provider and its `devices` Blocks (the Dial Options are shared)
+var deviceSettings = []string{
+	"username", "password", "tls", "ca_certificate", "insecure", "port",
+	"ca_certificate_pem", "client_certificate", "client_key", "tls_server_name", "tls_min_version",
+	"connect_timeout", "keepalive", "bind_address",
+}
+
+// deviceInheritedSettings lists the Settings a Device takes from the Provider when it does not set them
+var deviceInheritedSettings = map[string]bool{
+	"username": true, "password": true, "ca_certificate": true,
+	"ca_certificate_pem": true, "client_certificate": true, "client_key": true, "tls_min_version": true,
+}
+
+/**
+ * Function used to build the Client of a Device from its Settings
+ */
+func deviceToClient(host string, device map[string]interface{}, provider map[string]interface{}) (*client.Mikrotik, error) {
+
+	// Get a String Setting (inherited from the Provider when empty)
+	setting := func(name string) string {
+		value := device[name].(string)
+		if value == "" && deviceInheritedSettings[name] {
+			value = provider[name].(string)
+		}
+		return value
+	}
+
+	// Get Password (from the Device Password File before the Provider's)
+	password := device["password"].(string)
+	if file, _ := device["password_file"].(string); password == "" && file != "" {
+		var err error
+		if password, err = devicePassword(file); err != nil {
+			return nil, err
+		}
+	}
+	if password == "" {
+		password = provider["password"].(string)
+	}
+
+	// Build Client
+	c := client.NewClient(host, setting("username"), password, device["tls"].(bool), setting("ca_certificate"), device["insecure"].(bool))
+	c.CAPem = setting("ca_certificate_pem")
+	c.ClientCertificate = setting("client_certificate")
+	c.ClientKey = setting("client_key")
+	c.ServerName = setting("tls_server_name")
+	c.MinTLSVersion = setting("tls_min_version")
+	c.Port = device["port"].(int)
+
+	// Set Dial Options (shared by every Device)
+	c.BindAddress = provider["bind_address"].(string)
+	if timeout := provider["connect_timeout"].(string); timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("connect_timeout: %w", err)
+		}
+		c.ConnectTimeout = duration
+	}
+	if keepalive := provider["keepalive"].(string); keepalive != "" {
+		duration, err := time.ParseDuration(keepalive)
+		if err != nil {
+			return nil, fmt.Errorf("keepalive: %w", err)
+		}
+
+		// A zero Period disables Keepalives
+		if duration == 0 {
+			duration = -1
+		}
+		c.KeepAlive = duration
+	}
+
+	// Return Client
+	return c, nil
+}
+
+/**
+ * Function used to get the Client of a Device from the Provider Meta
+ */
+func deviceClient(device string, m interface{}) (*client.Mikrotik, error) {
+
+	// If Meta is already a Client
+	if c, ok := m.(*client.Mikrotik); ok {
+		return c, nil
+	}
+
+	// Get Device Client
+	return m.(*client.Devices).Get(device)
+}
+
+/**
+ * Function used to add the `device` Attribute to a Resource, and to run its Callbacks with the
+ * Client of that Device instead of the Provider Meta
+ */
+func withDevice(resource *schema.Resource) *schema.Resource {
+
+	// Add Device Attribute
+	resource.Schema["device"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validateOptionalName,
+		Description:      "Name of the provider `devices` entry managing this resource. The provider's own `host` when empty.",
+	}
+
+	// Wrap CRUD Callbacks
+	resource.CreateContext = onDevice(holdingConnectivityGuard(resource.CreateContext))
+	resource.ReadContext = onDevice(resource.ReadContext)
+	resource.UpdateContext = onDevice(holdingConnectivityGuard(resource.UpdateContext))
+	resource.DeleteContext = onDevice(holdingConnectivityGuard(resource.DeleteContext))
+
+	// If Resource has a Diff Customization
+	if customizeDiff := resource.CustomizeDiff; customizeDiff != nil {
+		resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			c, err := deviceClient(d.Get("device").(string), m)
+			if err != nil {
+				return err
+			}
+			return customizeDiff(ctx, d, c)
+		}
+	}
+
+	// If Resource can be Imported
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		importer := resource.Importer.StateContext
+		resource.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+			// If Import ID starts with a known Device Name (`<device>/<id>`)
+			if devices, ok := m.(*client.Devices); ok {
+				if index := strings.Index(d.Id(), "/"); index > 0 {
+					if _, err := devices.Get(d.Id()[:index]); err == nil {
+						d.Set("device", d.Id()[:index])
+						d.SetId(d.Id()[index+1:])
+					}
+				}
+			}
+
+			// Get Device Client
+			c, err := deviceClient(d.Get("device").(string), m)
+			if err != nil {
+				return nil, err
+			}
+
+			// Import on the Device
+			return importer(ctx, d, c)
+		}
+	}
+
+	// Wrap State Upgraders (older States have no Device, they are upgraded on the default Device)
+	for i := range resource.StateUpgraders {
+		upgrade := resource.StateUpgraders[i].Upgrade
+		resource.StateUpgraders[i].Upgrade = func(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+			device, _ := rawState["device"].(string)
+			c, err := deviceClient(device, m)
+			if err != nil {
+				return nil, err
+			}
+			return upgrade(ctx, rawState, c)
+		}
+	}
+
+	// Return Resource
+	return resource
+}
+
+/**
+ * Function used to add the `device` Attribute to a Data Source, and to read it with the Client of that Device
+ */
+func dataSourceWithDevice(dataSource *schema.Resource) *schema.Resource {
+
+	// Add Device Attribute
+	dataSource.Schema["device"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateOptionalName,
+		Description:      "Name of the provider `devices` entry to read from. The provider's own `host` when empty.",
+	}
+
+	// Wrap Read Callback
+	dataSource.ReadContext = onDevice(dataSource.ReadContext)
+
+	// Return Data Source
+	return dataSource
+}
+
+/**
+ * Function used to wrap a CRUD Callback so that it runs with the Client of the Resource Device
+ */
+func onDevice(callback func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+
+	// If there is no Callback
+	if callback == nil {
+		return nil
+	}
+
+	// Build and Return Callback
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+		// Get Device Client
+		c, err := deviceClient(d.Get("device").(string), m)
+
+		// If there is Error
+		if err != nil {
+
+			// Return Error
+			return diag.FromErr(err)
+		}
+
+		// Run Callback
+		return callback(ctx, d, c)
+	}
+}
+
+/**
+ * Function used to wrap a Change Callback so that it holds the Connectivity Guard of its Device: the Guard is
+ * disarmed as soon as no Change runs anymore, while Terraform still waits for the Change, and a Router that
+ * cannot be reached to disarm it fails the Change
+ */
+func holdingConnectivityGuard(callback func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+
+	// If there is no Callback
+	if callback == nil {
+		return nil
+	}
+
+	// Build and Return Callback
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+		// Hold Connectivity Guard
+		release := m.(*client.Mikrotik).HoldConnectivityGuard()
+
+		// Run Callback
+		diags := callback(ctx, d, m)
+
+		// Release Connectivity Guard (the Router rolls back if it cannot be reached to disarm it)
+		if err := release(); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		// Return Diagnostics
+		return diags
+	}
+}
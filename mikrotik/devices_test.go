@@ -0,0 +1,334 @@
+package mikrotik
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-routeros/routeros/proto"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestConfigureDevices(t *testing.T) {
+	provider := Provider(nil)
+	d := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+		"host":            "10.0.0.1:8728",
+		"username":        "admin",
+		"password":        "secret",
+		"connect_timeout": "10s",
+		"keepalive":       "0s",
+		"devices": []interface{}{
+			map[string]interface{}{"name": "branch-1", "host": "2001:db8::1", "port": 9000, "tls": true},
+			map[string]interface{}{"name": "branch-2", "host": "10.2.0.1:8728", "username": "ops"},
+		},
+	})
+
+	devices, err := configureDevices(d, func(c *client.Mikrotik) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		device   string
+		address  string
+		username string
+		tls      bool
+	}{
+		{device: "", address: "10.0.0.1:8728", username: "admin"},
+		{device: "branch-1", address: "[2001:db8::1]:9000", username: "admin", tls: true},
+		{device: "branch-2", address: "10.2.0.1:8728", username: "ops"},
+	}
+	for _, tc := range cases {
+		c, err := deviceClient(tc.device, devices)
+		if err != nil {
+			t.Fatalf("device `%s`: %v", tc.device, err)
+		}
+		address, _ := c.Address()
+		if address != tc.address || c.Username != tc.username || c.Password != "secret" || c.TLS != tc.tls {
+			t.Errorf("device `%s` does not match what we expected. actual: %+v", tc.device, c)
+		}
+		if c.ConnectTimeout != 10*time.Second || c.KeepAlive != -1 {
+			t.Errorf("device `%s` does not use the provider dial options. actual: %+v", tc.device, c)
+		}
+	}
+
+	if _, err := deviceClient("branch-3", devices); err == nil {
+		t.Errorf("expected unknown device to be rejected")
+	}
+}
+
+func TestImportOnDevice(t *testing.T) {
+	devices := client.NewDevices()
+	devices.Add("branch-1", client.NewClient("10.1.0.1:8728", "admin", "", false, "", false))
+
+	resource := withDevice(&schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Required: true, ForceNew: true}},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				if m.(*client.Mikrotik).Host != "10.1.0.1:8728" {
+					t.Errorf("import did not run on the device client: %+v", m)
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	})
+
+	d := resource.Data(nil)
+	d.SetId("branch-1/name=10.0.0.0/24")
+	if _, err := resource.Importer.StateContext(context.Background(), d, devices); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "name=10.0.0.0/24" || d.Get("device").(string) != "branch-1" {
+		t.Errorf("the import ID was not split on the device name: id=%s device=%s", d.Id(), d.Get("device"))
+	}
+}
+
+func TestConfigureRejectsUnparsablePem(t *testing.T) {
+	provider := Provider(nil)
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":     "10.0.0.1:8729",
+		"username": "admin",
+		"devices": []interface{}{
+			map[string]interface{}{"name": "branch-1", "host": "10.1.0.1:8729", "tls": true, "ca_certificate_pem": "not a certificate"},
+		},
+	}))
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "device `branch-1`: ca_certificate_pem:") {
+		t.Errorf("expected the unparsable PEM to be reported, got: %v", diags)
+	}
+}
+
+func TestConfigureDevicesFromProfile(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	ioutil.WriteFile(passwordFile, []byte("from-file\n"), 0600)
+	credentials := filepath.Join(dir, "credentials")
+	ioutil.WriteFile(credentials, []byte("[lab]\nhost = 10.0.0.1\nuser = terraform\npassword = from-profile\ntls = true\nport = 9000\n"), 0600)
+	os.Setenv("MIKROTIK_CREDENTIALS_FILE", credentials)
+	defer os.Unsetenv("MIKROTIK_CREDENTIALS_FILE")
+	for _, key := range []string{"MIKROTIK_HOST", "MIKROTIK_PASSWORD", "MIKROTIK_PASSWORD_FILE", "MIKROTIK_TLS", "MIKROTIK_PORT"} {
+		if value, ok := os.LookupEnv(key); ok {
+			os.Unsetenv(key)
+			defer os.Setenv(key, value)
+		}
+	}
+
+	provider := Provider(nil)
+	d := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+		"profile":  "lab",
+		"username": "admin",
+		"devices": []interface{}{
+			map[string]interface{}{"name": "branch-1", "host": "10.1.0.1", "password_file": passwordFile},
+		},
+	})
+
+	devices, err := configureDevices(d, func(c *client.Mikrotik) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, _ := deviceClient("", devices)
+	if address, _ := c.Address(); address != "10.0.0.1:9000" || c.Username != "admin" || c.Password != "from-profile" || !c.TLS {
+		t.Errorf("the default device does not match the profile. actual: %+v", c)
+	}
+	c, _ = deviceClient("branch-1", devices)
+	if c.Username != "admin" || c.Password != "from-file" {
+		t.Errorf("device `branch-1` does not match what we expected. actual: %+v", c)
+	}
+
+	d = schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{"profile": "lab", "tls": false})
+	devices, err = configureDevices(d, func(c *client.Mikrotik) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, _ = deviceClient("", devices); c.TLS {
+		t.Errorf("the profile overrode tls set to false in the configuration. actual: %+v", c)
+	}
+
+	d.Set("profile", "missing")
+	if _, err := configureDevices(d, func(c *client.Mikrotik) error { return nil }); err == nil {
+		t.Errorf("expected missing profile to be rejected")
+	}
+}
+
+// guardRouter is a Dialer keeping the objects added to each menu, refusing new connections once a
+// command locking the provider out was run
+type guardRouter struct {
+	mutex   sync.Mutex
+	menus   map[string][]map[string]string
+	next    int
+	lockout string
+	locked  bool
+}
+
+func (router *guardRouter) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+	if router.locked {
+		return nil, errors.New("connection refused")
+	}
+
+	conn, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		reader, writer := bufio.NewReader(server), proto.NewWriter(server)
+		for {
+			words, err := readGuardSentence(reader)
+			if err != nil {
+				return
+			}
+			rows, done := router.handle(words)
+			for _, row := range rows {
+				writer.BeginSentence()
+				writer.WriteWord("!re")
+				for key, value := range row {
+					writer.WriteWord("=" + key + "=" + value)
+				}
+				writer.EndSentence()
+			}
+			writer.BeginSentence()
+			for _, word := range done {
+				writer.WriteWord(word)
+			}
+			if err := writer.EndSentence(); err != nil {
+				return
+			}
+		}
+	}()
+	return conn, nil
+}
+
+// readGuardSentence reads the words of a sentence, queries included (the proto reader only takes replies)
+func readGuardSentence(reader *bufio.Reader) ([]string, error) {
+	words := []string{}
+	for {
+		first, err := reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		length := int(first)
+		if first&0x80 != 0 {
+			next, err := reader.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			length = int(first&0x3F)<<8 | int(next)
+		}
+		if length == 0 {
+			return words, nil
+		}
+		word := make([]byte, length)
+		if _, err := io.ReadFull(reader, word); err != nil {
+			return nil, err
+		}
+		words = append(words, string(word))
+	}
+}
+
+func (router *guardRouter) handle(words []string) ([]map[string]string, []string) {
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+
+	router.locked = router.locked || words[0] == router.lockout
+	index := strings.LastIndex(words[0], "/")
+	menu, action := words[0][:index], words[0][index+1:]
+	args := map[string]string{}
+	for _, word := range words[1:] {
+		if pair := strings.SplitN(strings.TrimLeft(word, "=?"), "=", 2); len(pair) == 2 {
+			args[pair[0]] = pair[1]
+		}
+	}
+
+	switch {
+	case menu == "/system/backup" && action == "save":
+		router.next++
+		router.menus["/file"] = append(router.menus["/file"], map[string]string{".id": fmt.Sprintf("*%d", router.next), "name": args["name"] + ".backup"})
+	case action == "add":
+		router.next++
+		args[".id"] = fmt.Sprintf("*%d", router.next)
+		if interval, ok := args["interval"]; ok {
+			args["interval"] = interval + "s"
+		}
+		router.menus[menu] = append(router.menus[menu], args)
+		return nil, []string{"!done", "=ret=" + args[".id"]}
+	case action == "remove":
+		kept := []map[string]string{}
+		for _, row := range router.menus[menu] {
+			if row[".id"] != args[".id"] && row[".id"] != args["numbers"] && row["name"] != args["numbers"] {
+				kept = append(kept, row)
+			}
+		}
+		router.menus[menu] = kept
+	case action == "print":
+		rows := []map[string]string{}
+		for _, row := range router.menus[menu] {
+			if (args["name"] == "" || row["name"] == args["name"]) && (args[".id"] == "" || row[".id"] == args[".id"]) {
+				rows = append(rows, row)
+			}
+		}
+		return rows, []string{"!done"}
+	}
+	return nil, []string{"!done"}
+}
+
+func TestChangeDisarmsConnectivityGuard(t *testing.T) {
+	router := &guardRouter{menus: map[string][]map[string]string{}}
+	c := &client.Mikrotik{Host: "router", Username: "admin", Dialer: router}
+	c.EnableConnectivityGuard(5 * time.Minute)
+
+	resource := withDevice(&schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Required: true}},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			pool, err := m.(*client.Mikrotik).AddPool(&client.Pool{Name: d.Get("name").(string), Ranges: "10.0.0.10-10.0.0.20"})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(pool.Id)
+			return nil
+		},
+	})
+	scheduled := func() bool {
+		router.mutex.Lock()
+		defer router.mutex.Unlock()
+		return len(router.menus["/system/scheduler"]) > 0
+	}
+
+	// The rollback is cancelled before the change returns to Terraform, not when the plugin exits
+	d := resource.Data(nil)
+	d.Set("name", "guarded")
+	if diags := resource.CreateContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("the guarded change failed: %v", diags)
+	}
+	if len(router.menus["/file"]) != 1 || len(router.menus["/ip/pool"]) != 1 {
+		t.Fatalf("the change was not guarded by a backup: %v", router.menus)
+	}
+	if scheduled() {
+		t.Fatal("the rollback was still scheduled once the change returned")
+	}
+
+	// A change that locks the provider out fails, and the router rolls back
+	d = resource.Data(nil)
+	d.Set("name", "lockout")
+	router.mutex.Lock()
+	router.lockout = "/ip/pool/add"
+	router.mutex.Unlock()
+	diags := resource.CreateContext(context.Background(), d, c)
+	if summary := fmt.Sprint(diags); !diags.HasError() || !strings.Contains(summary, "it rolls back in 5m0s") {
+		t.Errorf("expected the change to report the pending rollback, got: %v", diags)
+	}
+	if !scheduled() {
+		t.Error("the rollback was cancelled although the router could not be reached")
+	}
+}
@@ -0,0 +1,54 @@
+package mikrotik
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Function used to turn a Read Error into Diagnostics. An Object deleted out of band (e.g. in WinBox)
+ * is removed from State instead, so that the next Plan proposes to recreate it
+ */
+func readError(d *schema.ResourceData, err error) diag.Diagnostics {
+
+	// If Object does not exist anymore
+	if client.IsNotFound(err) {
+
+		// Log Removal
+		log.Printf("[WARN] Object `%s` not found on the device, removing it from state: %v", d.Id(), err)
+
+		// Remove Resource from State
+		d.SetId("")
+
+		// Return no Diagnostics
+		return nil
+	}
+
+	// Return Error
+	return diag.FromErr(err)
+}
+
+/**
+ * Function used to turn a Delete Error into Diagnostics. An Object that is already gone counts as deleted
+ */
+func deleteError(d *schema.ResourceData, err error) diag.Diagnostics {
+
+	// If Object does not exist anymore
+	if client.IsNotFound(err) {
+
+		// Log Deletion
+		log.Printf("[WARN] Object `%s` already deleted from the device: %v", d.Id(), err)
+
+		// Remove Resource from State
+		d.SetId("")
+
+		// Return no Diagnostics
+		return nil
+	}
+
+	// Return Error
+	return diag.FromErr(err)
+}
@@ -0,0 +1,915 @@
+package mikrotik
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Define Configuration Generator Source (a live router or a parsed `/export`)
+ */
+type GeneratorSource struct {
+	Client *client.Mikrotik
+	Export *client.Export
+}
+
+/**
+ * Define Generated Resource Description
+ */
+type generatorResource struct {
+	resourceType string
+	menu         string
+	model        interface{}
+	importKeys   []string
+	list         func(c *client.Mikrotik) (interface{}, error)
+	toData       func(record interface{}, d *schema.ResourceData)
+	defaults     []string
+}
+
+/**
+ * Define Cross Resource Reference (attribute holding the name of another resource)
+ */
+type generatorReference struct {
+	resourceType string
+	attribute    string
+	targets      []string
+}
+
+/**
+ * Define Generated HCL Block
+ */
+type generatorBlock struct {
+	resourceType string
+	label        string
+	importId     string
+	attributes   []generatorAttribute
+}
+
+/**
+ * Define Generated HCL Attribute
+ */
+type generatorAttribute struct {
+	name       string
+	value      interface{}
+	expression string
+}
+
+// Attributes used (in order) to derive resource labels
+var generatorLabelAttributes = []string{"name", "comment", "interface", "address", "macaddress", "chain"}
+
+// Supported Resources in dependency order
+var generatorResources = []generatorResource{
+	{
+		resourceType: "mikrotik_interface_list",
+		menu:         "/interface/list",
+		model:        client.InterfaceList{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListInterfaceLists() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			recordInterfaceListToData(r.(*client.InterfaceList), d)
+		},
+		defaults: []string{"all", "none", "dynamic", "static"},
+	},
+	{
+		resourceType: "mikrotik_bridge_interface",
+		menu:         "/interface/bridge",
+		model:        client.BridgeInterface{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListBridgeInterfaces() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			recordBridgeInterfaceToData(r.(*client.BridgeInterface), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_vlan_interface",
+		menu:         "/interface/vlan",
+		model:        client.VlanInterface{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListVlanInterfaces() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			recordVlanInterfaceToData(r.(*client.VlanInterface), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_bridge_interface_port",
+		menu:         "/interface/bridge/port",
+		model:        client.BridgeInterfacePort{},
+		importKeys:   []string{"Bridge", "Interface"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListBridgeInterfacePorts() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			recordBridgeInterfacePortToData(r.(*client.BridgeInterfacePort), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_interface_list_member",
+		menu:         "/interface/list/member",
+		model:        client.InterfaceListMember{},
+		importKeys:   []string{"List", "Interface"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListInterfaceListMembers() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			recordInterfaceListMemberToData(r.(*client.InterfaceListMember), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_ip_address",
+		menu:         "/ip/address",
+		model:        client.IpAddress{},
+		importKeys:   []string{"Address", "Interface"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpAddress() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			addrToData(r.(*client.IpAddress), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_ip_arp",
+		menu:         "/ip/arp",
+		model:        client.IpArp{},
+		importKeys:   []string{"Address", "Interface"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpArp() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			ipArpToData(r.(*client.IpArp), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_ipv6_address",
+		menu:         "/ipv6/address",
+		model:        client.Ipv6Address{},
+		importKeys:   []string{"Address", "Interface"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpv6Address() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			v6addrToData(r.(*client.Ipv6Address), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_pool",
+		menu:         "/ip/pool",
+		model:        client.Pool{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListPools() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			poolToData(r.(*client.Pool), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_dhcp_server",
+		menu:         "/ip/dhcp-server",
+		model:        client.DhcpServer{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListDhcpServers() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			dhcpServerToData(r.(*client.DhcpServer), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_dhcp_server_network",
+		menu:         "/ip/dhcp-server/network",
+		model:        client.DhcpServerNetwork{},
+		importKeys:   []string{"Address"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListDhcpServerNetworks() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			dhcpServerNetworkToData(r.(*client.DhcpServerNetwork), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_dhcp_lease",
+		menu:         "/ip/dhcp-server/lease",
+		model:        client.DhcpLease{},
+		importKeys:   []string{"MacAddress"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListDhcpLeases() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			leaseToData(r.(*client.DhcpLease), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_dns_record",
+		menu:         "/ip/dns/static",
+		model:        client.DnsRecord{},
+		importKeys:   []string{"Name", "Address"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListDnsRecords() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			recordToData(r.(*client.DnsRecord), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_script",
+		menu:         "/system/script",
+		model:        client.Script{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListScripts() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			scriptToData(r.(*client.Script), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_scheduler",
+		menu:         "/system/scheduler",
+		model:        client.Scheduler{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListSchedulers() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			schedulerToData(r.(*client.Scheduler), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_tftp",
+		menu:         "/ip/tftp",
+		model:        client.Tftp{},
+		importKeys:   []string{"RequestFileName"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListTftp() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			tftpToData(r.(*client.Tftp), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_firewall_rule",
+		menu:         "/ip/firewall/filter",
+		model:        client.FirewallRule{},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListFirewallRule() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			firewallRuleToData(r.(*client.FirewallRule), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_firewall_nat",
+		menu:         "/ip/firewall/nat",
+		model:        client.FirewallNat{},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListFirewallNat() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			firewallNatToData(r.(*client.FirewallNat), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_firewall_mangle",
+		menu:         "/ip/firewall/mangle",
+		model:        client.FirewallMangle{},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListFirewallMangle() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			firewallMangleToData(r.(*client.FirewallMangle), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_firewall_raw",
+		menu:         "/ip/firewall/raw",
+		model:        client.FirewallRaw{},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListFirewallRaw() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			firewallRawToData(r.(*client.FirewallRaw), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_ipsec_proposal",
+		menu:         "/ip/ipsec/proposal",
+		model:        client.IpSecProposal{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpSecProposal() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			ipsecProposalToData(r.(*client.IpSecProposal), d)
+		},
+		defaults: []string{"default"},
+	},
+	{
+		resourceType: "mikrotik_ipsec_profile",
+		menu:         "/ip/ipsec/profile",
+		model:        client.IpSecProfile{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpSecProfile() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			ipsecProfileToData(r.(*client.IpSecProfile), d)
+		},
+		defaults: []string{"default"},
+	},
+	{
+		resourceType: "mikrotik_ipsec_policy_group",
+		menu:         "/ip/ipsec/policy/group",
+		model:        client.IpSecPolicyGroup{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpSecPolicyGroup() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			ipsecPolicyGroupToData(r.(*client.IpSecPolicyGroup), d)
+		},
+		defaults: []string{"default"},
+	},
+	{
+		resourceType: "mikrotik_ipsec_peer",
+		menu:         "/ip/ipsec/peer",
+		model:        client.IpSecPeer{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpSecPeer() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			ipsecPeerToData(r.(*client.IpSecPeer), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_ipsec_identity",
+		menu:         "/ip/ipsec/identity",
+		model:        client.IpSecIdentity{},
+		importKeys:   []string{"Peer"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpSecIdentity() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			ipsecIdentityToData(r.(*client.IpSecIdentity), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_ipsec_policy",
+		menu:         "/ip/ipsec/policy",
+		model:        client.IpSecPolicy{},
+		importKeys:   []string{"SourceAddress", "DestinationAddress"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListIpSecPolicy() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			ipsecPolicyToData(r.(*client.IpSecPolicy), d)
+		},
+	},
+	{
+		resourceType: "mikrotik_bgp_instance",
+		menu:         "/routing/bgp/instance",
+		model:        client.BgpInstance{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListBgpInstances() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			bgpInstanceToData(r.(*client.BgpInstance), d)
+		},
+		defaults: []string{"default"},
+	},
+	{
+		resourceType: "mikrotik_bgp_peer",
+		menu:         "/routing/bgp/peer",
+		model:        client.BgpPeer{},
+		importKeys:   []string{"Name"},
+		list:         func(c *client.Mikrotik) (interface{}, error) { return c.ListBgpPeers() },
+		toData: func(r interface{}, d *schema.ResourceData) {
+			bgpPeerToData(r.(*client.BgpPeer), d)
+		},
+	},
+}
+
+// Interfaces that can be referenced by name
+var generatorInterfaces = []string{"mikrotik_bridge_interface", "mikrotik_vlan_interface"}
+
+// Attributes referencing other resources by name
+var generatorReferences = []generatorReference{
+	{"mikrotik_vlan_interface", "interface", generatorInterfaces},
+	{"mikrotik_bridge_interface_port", "bridge", []string{"mikrotik_bridge_interface"}},
+	{"mikrotik_bridge_interface_port", "interface", generatorInterfaces},
+	{"mikrotik_interface_list_member", "list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_interface_list_member", "interface", generatorInterfaces},
+	{"mikrotik_ip_address", "interface", generatorInterfaces},
+	{"mikrotik_ip_arp", "interface", generatorInterfaces},
+	{"mikrotik_ipv6_address", "interface", generatorInterfaces},
+	{"mikrotik_pool", "next_pool", []string{"mikrotik_pool"}},
+	{"mikrotik_dhcp_server", "interface", generatorInterfaces},
+	{"mikrotik_dhcp_server", "address_pool", []string{"mikrotik_pool"}},
+	{"mikrotik_scheduler", "on_event", []string{"mikrotik_script"}},
+	{"mikrotik_firewall_rule", "in_interface", generatorInterfaces},
+	{"mikrotik_firewall_rule", "out_interface", generatorInterfaces},
+	{"mikrotik_firewall_rule", "in_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_firewall_rule", "out_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_firewall_nat", "in_interface", generatorInterfaces},
+	{"mikrotik_firewall_nat", "out_interface", generatorInterfaces},
+	{"mikrotik_firewall_nat", "in_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_firewall_nat", "out_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_firewall_mangle", "in_interface", generatorInterfaces},
+	{"mikrotik_firewall_mangle", "out_interface", generatorInterfaces},
+	{"mikrotik_firewall_mangle", "in_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_firewall_mangle", "out_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_firewall_raw", "in_interface", generatorInterfaces},
+	{"mikrotik_firewall_raw", "out_interface", generatorInterfaces},
+	{"mikrotik_firewall_raw", "in_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_firewall_raw", "out_interface_list", []string{"mikrotik_interface_list"}},
+	{"mikrotik_ipsec_peer", "profile", []string{"mikrotik_ipsec_profile"}},
+	{"mikrotik_ipsec_identity", "peer", []string{"mikrotik_ipsec_peer"}},
+	{"mikrotik_ipsec_identity", "policy_template_group", []string{"mikrotik_ipsec_policy_group"}},
+	{"mikrotik_ipsec_policy", "peer", []string{"mikrotik_ipsec_peer"}},
+	{"mikrotik_ipsec_policy", "proposal", []string{"mikrotik_ipsec_proposal"}},
+	{"mikrotik_bgp_peer", "instance", []string{"mikrotik_bgp_instance"}},
+}
+
+/**
+ * Function used to Generate HCL Resources and Import Blocks from a Router Configuration
+ */
+func GenerateConfiguration(source GeneratorSource, w io.Writer) error {
+
+	// Get Provider Resources (schemas are used to drop default values)
+	resources := Provider(nil).ResourcesMap
+
+	// Generated Blocks
+	blocks := []*generatorBlock{}
+
+	// Used Labels per Resource Type
+	labels := map[string]map[string]bool{}
+
+	// Iterate on Supported Resources
+	for _, entry := range generatorResources {
+
+		// Get Resource
+		resource := resources[entry.resourceType]
+
+		// List Resource Records
+		records, defaults, present, err := entry.records(source)
+
+		// If There is Error
+		if err != nil {
+			return fmt.Errorf("listing %s: %v", entry.resourceType, err)
+		}
+
+		// Iterate on Records
+		for idx := 0; idx < records.Len(); idx++ {
+
+			// Get Record Pointer
+			record := records.Index(idx).Addr().Interface()
+
+			// Skip Default and Dynamic Objects
+			if defaults[idx] || entry.isDefault(record) || generatorBoolField(record, "Dynamic") {
+				continue
+			}
+
+			// Convert Record to Resource Data
+			d := resource.Data(nil)
+			entry.toData(record, d)
+
+			// Build Block
+			block := &generatorBlock{
+				resourceType: entry.resourceType,
+				importId:     d.Id(),
+				attributes:   generatorAttributes(resource, d, generatorAbsentAttributes(record, present[idx])),
+			}
+
+			// Fall back to a Natural Key when the ID is unknown (export)
+			if block.importId == "" {
+				block.importId = generatorNaturalKey(record, entry.importKeys)
+			}
+
+			// Compute Unique Label
+			if labels[entry.resourceType] == nil {
+				labels[entry.resourceType] = map[string]bool{}
+			}
+			block.label = generatorLabel(entry.resourceType, d, labels[entry.resourceType])
+
+			// Append Block
+			blocks = append(blocks, block)
+		}
+	}
+
+	// Turn Name References into Expressions
+	generatorResolveReferences(blocks)
+
+	// Write Blocks
+	return generatorWrite(blocks, w)
+}
+
+/**
+ * Function used to build the Client of the Router to generate from, resolving its Settings like the Provider:
+ * the given ones, else their `MIKROTIK_*` Environment Variables, else the Credentials Profile
+ */
+func GeneratorClient(settings map[string]interface{}) (*client.Mikrotik, error) {
+
+	// Get Provider Schema (its Defaults read the Environment)
+	providerSchema := Provider(nil).Schema
+
+	// Resolve each Setting (a Boolean is explicit once set)
+	resolved, explicit := map[string]interface{}{}, map[string]bool{}
+	for _, setting := range append([]string{"host", "password_file", "profile"}, deviceSettings...) {
+		attribute := providerSchema[setting]
+
+		// Get given Setting, else its Environment Default
+		value, ok := settings[setting]
+		if !ok {
+			var err error
+			if value, err = attribute.DefaultValue(); err != nil {
+				return nil, err
+			}
+		}
+		explicit[setting] = value != nil
+
+		// Convert Environment Values (Strings) to the Setting Type
+		var err error
+		text, isText := value.(string)
+		switch attribute.Type {
+		case schema.TypeBool:
+			if _, ok := value.(bool); !ok {
+				value = false
+				if text != "" {
+					value, err = strconv.ParseBool(text)
+				}
+			}
+		case schema.TypeInt:
+			if _, ok := value.(int); !ok {
+				value = 0
+				if text != "" {
+					value, err = strconv.Atoi(text)
+				}
+			}
+		default:
+			if !isText {
+				value = ""
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", setting, err)
+		}
+		resolved[setting] = value
+	}
+
+	// Fill empty Settings from the Credentials Profile
+	if err := applyProfile(resolved["profile"].(string), resolved, explicit); err != nil {
+		return nil, err
+	}
+
+	// Build Client
+	return deviceToClient(resolved["host"].(string), resolved, resolved)
+}
+
+/**
+ * Function used to List Records of a Resource (reflect slice) with their Default Flags
+ */
+func (entry generatorResource) records(source GeneratorSource) (reflect.Value, []bool, []map[string]bool, error) {
+
+	// If Source is an Export File
+	if source.Export != nil {
+
+		// Unmarshal Export Objects
+		slice := reflect.New(reflect.SliceOf(reflect.TypeOf(entry.model)))
+		if err := source.Export.Unmarshal(entry.menu, slice.Interface()); err != nil {
+			return reflect.Value{}, nil, nil, err
+		}
+
+		// Objects only modified by the export are router defaults, and
+		// properties missing from an export keep their router default
+		defaults := []bool{}
+		present := []map[string]bool{}
+		for _, object := range source.Export.Objects(entry.menu) {
+			defaults = append(defaults, object.Default)
+			keys := map[string]bool{}
+			for _, pair := range object.Pairs {
+				keys[pair.Key] = true
+			}
+			present = append(present, keys)
+		}
+
+		// Return Records
+		return slice.Elem(), defaults, present, nil
+	}
+
+	// List Records from the Router
+	list, err := entry.list(source.Client)
+
+	// Legacy BGP menus do not exist on RouterOS v7
+	if _, ok := err.(client.LegacyBgpUnsupported); ok {
+		return reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(entry.model)), 0, 0), nil, nil, nil
+	}
+
+	// If There is Error
+	if err != nil {
+		return reflect.Value{}, nil, nil, err
+	}
+
+	// Return Records (the router returns every property but no default flag)
+	slice := reflect.ValueOf(list)
+	return slice, make([]bool, slice.Len()), make([]map[string]bool, slice.Len()), nil
+}
+
+/**
+ * Function used to check if a Record is a well known Default Object
+ */
+func (entry generatorResource) isDefault(record interface{}) bool {
+
+	// Get Record Name
+	name := generatorStringField(record, "Name")
+
+	// Iterate on Default Names
+	for _, defaultName := range entry.defaults {
+		if name == defaultName {
+			return true
+		}
+	}
+
+	// Return Result
+	return false
+}
+
+/**
+ * Function used to build the Attributes of a Block, dropping Computed and Default Values
+ */
+func generatorAttributes(resource *schema.Resource, d *schema.ResourceData, absent map[string]bool) []generatorAttribute {
+
+	// Sort Attribute Names
+	names := []string{}
+	for name := range resource.Schema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Required Attributes first, then Optional ones
+	attributes := []generatorAttribute{}
+	for _, required := range []bool{true, false} {
+		for _, name := range names {
+			attribute := resource.Schema[name]
+
+			// Skip Computed Only and non matching Attributes
+			if attribute.Required != required || (!attribute.Required && !attribute.Optional) {
+				continue
+			}
+
+			// Get Value
+			value := d.Get(name)
+			if set, ok := value.(*schema.Set); ok {
+				value = set.List()
+			}
+
+			// Skip Optional Default Values
+			if !attribute.Required && (absent[name] || generatorIsDefaultValue(attribute, value)) {
+				continue
+			}
+
+			attributes = append(attributes, generatorAttribute{name: name, value: value})
+		}
+	}
+
+	// Return Attributes
+	return attributes
+}
+
+/**
+ * Function used to find Attributes fed by Properties missing from an Export Object
+ */
+func generatorAbsentAttributes(record interface{}, present map[string]bool) map[string]bool {
+
+	// Every property is known when reading from the router
+	absent := map[string]bool{}
+	if present == nil {
+		return absent
+	}
+
+	// Iterate on Record Fields
+	elem := reflect.ValueOf(record).Elem()
+	for i := 0; i < elem.NumField(); i++ {
+		field := elem.Type().Field(i)
+		key := strings.Split(field.Tag.Get("mikrotik"), ",")[0]
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+
+		// Attributes follow property names (src/dst being spelled out)
+		if !present[key] {
+			attribute := strings.ReplaceAll(key, "-", "_")
+			attribute = generatorAttributeNames.Replace(attribute)
+			absent[attribute] = true
+			absent[strings.ToLower(field.Name)] = true
+		}
+	}
+
+	// Return Absent Attributes
+	return absent
+}
+
+// Prefixes spelled differently in schemas and RouterOS properties
+var generatorAttributeNames = strings.NewReplacer("src_", "source_", "dst_", "destination_")
+
+/**
+ * Function used to check if a Value equals the Schema Default (or the zero value)
+ */
+func generatorIsDefaultValue(attribute *schema.Schema, value interface{}) bool {
+
+	// Compare with Declared Default
+	if attribute.Default != nil {
+		return fmt.Sprint(attribute.Default) == fmt.Sprint(value)
+	}
+
+	// Compare with Zero Value
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case nil:
+		return true
+	}
+	return reflect.ValueOf(value).IsZero()
+}
+
+/**
+ * Function used to compute a Unique, Valid HCL Label for a Resource
+ */
+func generatorLabel(resourceType string, d *schema.ResourceData, used map[string]bool) string {
+
+	// Resource Short Name
+	short := strings.TrimPrefix(resourceType, "mikrotik_")
+
+	// Find First Usable Attribute Value
+	label := ""
+	for _, name := range generatorLabelAttributes {
+		if value, ok := d.Get(name).(string); ok && value != "" {
+			label = generatorSanitizeLabel(value)
+			if label != "" {
+				break
+			}
+		}
+	}
+
+	// Labels must start with a letter
+	if label == "" {
+		label = short
+	} else if label[0] >= '0' && label[0] <= '9' {
+		label = short + "_" + label
+	}
+
+	// Ensure Uniqueness
+	unique := label
+	for idx := 2; used[unique]; idx++ {
+		unique = fmt.Sprintf("%s_%d", label, idx)
+	}
+	used[unique] = true
+
+	// Return Label
+	return unique
+}
+
+// Characters not allowed in HCL labels
+var generatorLabelInvalid = regexp.MustCompile(`[^a-z0-9_]+`)
+
+/**
+ * Function used to turn any Value into a Label
+ */
+func generatorSanitizeLabel(value string) string {
+	label := generatorLabelInvalid.ReplaceAllString(strings.ToLower(value), "_")
+	return strings.Trim(label, "_")
+}
+
+/**
+ * Function used to replace Names referencing other Generated Resources by Expressions
+ */
+func generatorResolveReferences(blocks []*generatorBlock) {
+
+	// Index Labels by Resource Type and Name
+	names := map[string]map[string]string{}
+	for _, block := range blocks {
+		for _, attribute := range block.attributes {
+			if attribute.name == "name" {
+				if names[block.resourceType] == nil {
+					names[block.resourceType] = map[string]string{}
+				}
+				names[block.resourceType][fmt.Sprint(attribute.value)] = block.label
+			}
+		}
+	}
+
+	// Iterate on Blocks Attributes
+	for _, block := range blocks {
+		for idx, attribute := range block.attributes {
+			value, ok := attribute.value.(string)
+			if !ok || value == "" {
+				continue
+			}
+
+			// Iterate on Known References
+			for _, reference := range generatorReferences {
+				if reference.resourceType != block.resourceType || reference.attribute != attribute.name {
+					continue
+				}
+
+				// Find Referenced Resource
+				for _, target := range reference.targets {
+					if label, found := names[target][value]; found {
+						block.attributes[idx].expression = fmt.Sprintf("%s.%s.name", target, label)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+/**
+ * Function used to Write Resource and Import Blocks
+ */
+func generatorWrite(blocks []*generatorBlock, w io.Writer) error {
+
+	// Build Output
+	var out strings.Builder
+	out.WriteString("# Generated by terraform-provider-mikrotik generate\n")
+
+	// Iterate on Blocks
+	for _, block := range blocks {
+
+		// Compute Attribute Alignment
+		width := 0
+		for _, attribute := range block.attributes {
+			if len(attribute.name) > width {
+				width = len(attribute.name)
+			}
+		}
+
+		// Write Resource Block
+		fmt.Fprintf(&out, "\nresource %q %q {\n", block.resourceType, block.label)
+		for _, attribute := range block.attributes {
+			value := attribute.expression
+			if value == "" {
+				value = generatorHclValue(attribute.value)
+			}
+			fmt.Fprintf(&out, "  %-*s = %s\n", width, attribute.name, value)
+		}
+		out.WriteString("}\n")
+
+		// Write Import Block
+		if block.importId == "" {
+			fmt.Fprintf(&out, "\n# The MikroTik id of %s.%s is unknown, look it up on the device to import it.\n", block.resourceType, block.label)
+			continue
+		}
+		fmt.Fprintf(&out, "\nimport {\n  to = %s.%s\n  id = %s\n}\n", block.resourceType, block.label, generatorHclValue(block.importId))
+	}
+
+	// Write Output
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+/**
+ * Function used to format a Value as an HCL Literal
+ */
+func generatorHclValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return generatorHclString(v)
+	case []interface{}:
+		items := []string{}
+		for _, item := range v {
+			items = append(items, generatorHclValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := []string{}
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%s = %s", generatorHclString(key), generatorHclValue(v[key])))
+		}
+		return "{ " + strings.Join(items, ", ") + " }"
+	}
+	return fmt.Sprint(value)
+}
+
+// Escapes needed by HCL quoted strings (template sequences included)
+var generatorHclEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+/**
+ * Function used to format a String as an HCL Quoted String
+ */
+func generatorHclString(value string) string {
+	return `"` + generatorHclEscaper.Replace(value) + `"`
+}
+
+/**
+ * Function used to build the Natural Key (`property=value,...`) of a Record from its Key Fields
+ * ("" if a field is empty or holds a value the importer cannot parse back)
+ */
+func generatorNaturalKey(record interface{}, fields []string) string {
+	recordType := reflect.TypeOf(record).Elem()
+	filters := []client.QueryFilter{}
+	for _, name := range fields {
+		field, _ := recordType.FieldByName(name)
+		value := generatorStringField(record, name)
+		if value == "" || strings.ContainsAny(value, ",=") {
+			return ""
+		}
+		property := strings.Split(field.Tag.Get("mikrotik"), ",")[0]
+		filters = append(filters, client.QueryFilter{Property: property, Value: value})
+	}
+	return client.FormatQueryFilters(filters)
+}
+
+/**
+ * Function used to read a Bool Field of a Record (false if missing)
+ */
+func generatorBoolField(record interface{}, name string) bool {
+	field := reflect.ValueOf(record).Elem().FieldByName(name)
+	return field.IsValid() && field.Kind() == reflect.Bool && field.Bool()
+}
+
+/**
+ * Function used to read a String Field of a Record ("" if missing)
+ */
+func generatorStringField(record interface{}, name string) string {
+	field := reflect.ValueOf(record).Elem().FieldByName(name)
+	if field.IsValid() && field.Kind() == reflect.String {
+		return field.String()
+	}
+	return ""
+}
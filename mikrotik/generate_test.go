@@ -0,0 +1,157 @@
+package mikrotik
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-routeros/routeros/proto"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestGenerateConfigurationFromExport(t *testing.T) {
+	export, err := client.ParseExport(strings.NewReader(`/interface bridge
+add name=br0
+/interface bridge port
+add bridge=br0 interface=ether2
+/ip pool
+add name=dhcp ranges=10.0.0.100-10.0.0.200
+/ip dhcp-server
+add address-pool=dhcp interface=br0 name=lan
+/ip dns static
+add address=10.0.0.1 name=router.lan
+/system script
+add name=hello owner=admin policy=read,write source=":put \"\${a}\""
+/ip firewall filter
+add action=accept chain=forward
+/ip ipsec proposal
+set [ find default=yes ] enc-algorithms=aes-256-cbc
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	if err := GenerateConfiguration(GeneratorSource{Export: export}, &out); err != nil {
+		t.Fatal(err)
+	}
+	generated := out.String()
+
+	expected := []string{
+		`resource "mikrotik_bridge_interface" "br0" {`,
+		`resource "mikrotik_bridge_interface_port" "ether2" {`,
+		`  bridge    = mikrotik_bridge_interface.br0.name`,
+		`  address_pool = mikrotik_pool.dhcp.name`,
+		`  interface    = mikrotik_bridge_interface.br0.name`,
+		"import {\n  to = mikrotik_dhcp_server.lan\n  id = \"name=lan\"\n}",
+		"import {\n  to = mikrotik_dns_record.router_lan\n  id = \"name=router.lan,address=10.0.0.1\"\n}",
+		`  source = ":put \"$${a}\""`,
+		`  policy = ["read", "write"]`,
+		"import {\n  to = mikrotik_pool.dhcp\n  id = \"name=dhcp\"\n}",
+		`# The MikroTik id of mikrotik_firewall_rule.forward is unknown`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(generated, e) {
+			t.Errorf("expected generated configuration to contain %q, got:\n%s", e, generated)
+		}
+	}
+
+	if strings.Contains(generated, "mikrotik_ipsec_proposal") {
+		t.Errorf("expected default objects to be skipped, got:\n%s", generated)
+	}
+}
+
+// generatorRouter is a Dialer serving every print with one row flagged dynamic or not
+type generatorRouter struct {
+	dynamic string
+}
+
+func (router generatorRouter) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		reader, writer := proto.NewReader(server), proto.NewWriter(server)
+		for {
+			sentence, err := reader.ReadSentence()
+			if err != nil {
+				return
+			}
+			if strings.HasSuffix(sentence.Word, "/print") {
+				writer.BeginSentence()
+				for _, word := range []string{"!re", "=.id=*1", "=name=object", "=dynamic=" + router.dynamic} {
+					writer.WriteWord(word)
+				}
+				writer.EndSentence()
+			}
+			writer.BeginSentence()
+			writer.WriteWord("!done")
+			if err := writer.EndSentence(); err != nil {
+				return
+			}
+		}
+	}()
+	return conn, nil
+}
+
+func TestGenerateConfigurationSkipsDynamicObjects(t *testing.T) {
+	for _, dynamic := range []string{"false", "true"} {
+		c := &client.Mikrotik{Host: "router", Username: "admin", Dialer: generatorRouter{dynamic: dynamic}}
+
+		var out strings.Builder
+		if err := GenerateConfiguration(GeneratorSource{Client: c}, &out); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, entry := range generatorResources {
+			generated := strings.Contains(out.String(), `resource "`+entry.resourceType+`" `)
+			if dynamic == "true" && generated {
+				t.Errorf("expected the dynamic object of %s to be skipped, got:\n%s", entry.menu, out.String())
+			}
+			if dynamic == "false" && !generated {
+				t.Errorf("expected the static object of %s to be generated, got:\n%s", entry.menu, out.String())
+			}
+		}
+	}
+}
+
+func TestGeneratorClientFromProfile(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	ioutil.WriteFile(passwordFile, []byte("from-file\n"), 0600)
+	credentials := filepath.Join(dir, "credentials")
+	ioutil.WriteFile(credentials, []byte("[lab]\nhost = 10.0.0.1\nusername = terraform\npassword_file = password\ntls = true\nport = 9000\n"), 0600)
+	os.Setenv("MIKROTIK_CREDENTIALS_FILE", credentials)
+	defer os.Unsetenv("MIKROTIK_CREDENTIALS_FILE")
+	for _, key := range []string{"MIKROTIK_HOST", "MIKROTIK_USER", "MIKROTIK_PASSWORD", "MIKROTIK_PASSWORD_FILE", "MIKROTIK_PROFILE", "MIKROTIK_TLS", "MIKROTIK_PORT"} {
+		if value, ok := os.LookupEnv(key); ok {
+			os.Unsetenv(key)
+			defer os.Setenv(key, value)
+		}
+	}
+
+	c, err := GeneratorClient(map[string]interface{}{"profile": "lab"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address, _ := c.Address(); address != "10.0.0.1:9000" || c.Username != "terraform" || c.Password != "from-file" || !c.TLS {
+		t.Errorf("the generator client does not match the profile. actual: %+v", c)
+	}
+
+	os.Setenv("MIKROTIK_TLS", "false")
+	defer os.Unsetenv("MIKROTIK_TLS")
+	c, err = GeneratorClient(map[string]interface{}{"profile": "lab", "username": "admin", "password": "from-flag"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Username != "admin" || c.Password != "from-flag" || c.TLS {
+		t.Errorf("the flags and environment did not win over the profile. actual: %+v", c)
+	}
+
+	if _, err := GeneratorClient(map[string]interface{}{"profile": "missing"}); err == nil {
+		t.Errorf("expected missing profile to be rejected")
+	}
+}
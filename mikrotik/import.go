@@ -0,0 +1,178 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+// naturalKeyPropertyPattern matches the `property=` prefix of a natural key segment
+var naturalKeyPropertyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.-]*=`)
+
+// naturalKeyAliases maps import key shorthands to MikroTik property names
+var naturalKeyAliases = map[string]string{
+	"mac": "mac-address",
+}
+
+// naturalKeyPrefixes maps Terraform attribute prefixes to MikroTik property prefixes
+var naturalKeyPrefixes = strings.NewReplacer("source-", "src-", "destination-", "dst-")
+
+/**
+ * Function used to build an Importer accepting either the Resource ID or a Natural Key
+ * (`property=value,...`) resolved to the ID Property of the single matching Menu Item
+ */
+func importStateByNaturalKey(menu string, idProperty string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+		// If Import ID is not a Natural Key
+		if !strings.Contains(d.Id(), "=") {
+
+			// Use it as is
+			return []*schema.ResourceData{d}, nil
+		}
+
+		// Parse Natural Key
+		filters, err := parseNaturalKey(d.Id())
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return nil, err
+		}
+
+		// Get Mikrotik Client
+		c := m.(*client.Mikrotik)
+
+		// Resolve the Natural Key
+		id, err := c.ResolveProperty(menu, filters, idProperty)
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return nil, fmt.Errorf("cannot import `%s`: %w", d.Id(), err)
+		}
+
+		// Set Resolved ID
+		d.SetId(id)
+
+		// Return Resource Data
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+/**
+ * Function used to build an Importer accepting the Resource ID, a Natural Key or the bare value of
+ * the Key Property (e.g. `mikrotik_script.hello hello` for `name=hello`), all resolved to the `.id`
+ */
+func importStateByKeyProperty(menu string, property string) schema.StateContextFunc {
+
+	// Build Natural Key Importer
+	importer := importStateByNaturalKey(menu, ".id")
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+		// If Import ID is neither a MikroTik ID nor a Natural Key
+		if !strings.HasPrefix(d.Id(), "*") && !strings.Contains(d.Id(), "=") {
+
+			// Use it as the Key Property Value
+			d.SetId(property + "=" + d.Id())
+		}
+
+		// Resolve Import ID
+		return importer(ctx, d, m)
+	}
+}
+
+/**
+ * Function used to wrap an Importer setting Attributes that are not read back from the router (e.g. behaviour flags)
+ * to their Defaults, so that the first plan after an import does not show them changing from null
+ */
+func importStateWithDefaults(importer schema.StateContextFunc, defaults map[string]interface{}) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+		// Set Defaults
+		for key, value := range defaults {
+			if err := d.Set(key, value); err != nil {
+				return nil, err
+			}
+		}
+
+		// Resolve Import ID
+		return importer(ctx, d, m)
+	}
+}
+
+/**
+ * Function used to Parse a Natural Key (`property=value,...`) into Query Filters.
+ * A comma not followed by `property=` belongs to the previous value (e.g. `dst_port=80,443`).
+ */
+func parseNaturalKey(key string) ([]client.QueryFilter, error) {
+
+	// Initialize Filters
+	filters := []client.QueryFilter{}
+
+	// For each Comma Separated Segment
+	for _, segment := range strings.Split(key, ",") {
+
+		// If Segment does not start a new Property
+		if !naturalKeyPropertyPattern.MatchString(segment) {
+
+			// If There is no Previous Property
+			if len(filters) == 0 {
+
+				// Return Error
+				return nil, fmt.Errorf("invalid import key `%s`: expected `property=value[,property=value...]`", key)
+			}
+
+			// Append Segment to Previous Value
+			filters[len(filters)-1].Value += "," + segment
+
+			// Continue
+			continue
+		}
+
+		// Split Property and Value
+		parts := strings.SplitN(segment, "=", 2)
+		property, value := naturalKeyProperty(parts[0]), strings.Trim(parts[1], `"`)
+
+		// MAC Addresses are stored upper-case with colons
+		if strings.HasSuffix(property, "mac-address") {
+			value = normalize.MacAddress(value)
+		}
+
+		// Append Filter
+		filters = append(filters, client.QueryFilter{
+			Property: property,
+			Value:    value,
+		})
+	}
+
+	// Return Filters
+	return filters, nil
+}
+
+/**
+ * Function used to Convert an Import Key Property (Terraform Attribute, Alias or MikroTik Name) into a MikroTik Property
+ */
+func naturalKeyProperty(property string) string {
+
+	// Normalize Property
+	property = strings.ToLower(property)
+
+	// If Property is an Alias
+	if alias, ok := naturalKeyAliases[property]; ok {
+
+		// Return Aliased Property
+		return alias
+	}
+
+	// Convert Terraform Attribute Name
+	return naturalKeyPrefixes.Replace(strings.ReplaceAll(property, "_", "-"))
+}
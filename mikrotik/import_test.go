@@ -0,0 +1,72 @@
+package mikrotik
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestParseNaturalKey(t *testing.T) {
+	cases := []struct {
+		key      string
+		expected []client.QueryFilter
+	}{
+		{
+			key:      "bridge=br0,interface=ether2",
+			expected: []client.QueryFilter{{Property: "bridge", Value: "br0"}, {Property: "interface", Value: "ether2"}},
+		},
+		{
+			key:      "mac=AA:BB:CC:DD:EE:FF",
+			expected: []client.QueryFilter{{Property: "mac-address", Value: "AA:BB:CC:DD:EE:FF"}},
+		},
+		{
+			key:      `chain=forward,comment="allow-web"`,
+			expected: []client.QueryFilter{{Property: "chain", Value: "forward"}, {Property: "comment", Value: "allow-web"}},
+		},
+		{
+			key:      "chain=forward,destination_port=80,443,source_address=10.0.0.0/8",
+			expected: []client.QueryFilter{{Property: "chain", Value: "forward"}, {Property: "dst-port", Value: "80,443"}, {Property: "src-address", Value: "10.0.0.0/8"}},
+		},
+		{
+			key:      "mac_address=AA:BB:CC:DD:EE:FF",
+			expected: []client.QueryFilter{{Property: "mac-address", Value: "AA:BB:CC:DD:EE:FF"}},
+		},
+		{
+			key:      "mac=aa-bb-cc-dd-ee-ff",
+			expected: []client.QueryFilter{{Property: "mac-address", Value: "AA:BB:CC:DD:EE:FF"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.key, func(t *testing.T) {
+			filters, err := parseNaturalKey(tc.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(filters, tc.expected) {
+				t.Errorf("The filters do not match what we expected. actual: %v expected: %v", filters, tc.expected)
+			}
+		})
+	}
+
+	if _, err := parseNaturalKey("=value"); err == nil {
+		t.Error("expected error for a key without property, got nil")
+	}
+}
+
+func TestImportStateWithDefaults(t *testing.T) {
+	resource := resourceLease()
+	d := resource.TestResourceData()
+	d.SetId("*1")
+
+	imported, err := resource.Importer.StateContext(context.Background(), d, &client.Mikrotik{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if state := imported[0].State(); state.Attributes["adopt_dynamic"] != "false" {
+		t.Errorf("expected adopt_dynamic to be in the imported state, got %v", state.Attributes)
+	}
+}
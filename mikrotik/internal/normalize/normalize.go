@@ -0,0 +1,224 @@
+// Package normalize knows the canonical forms RouterOS rewrites values to (compressed IPv6 addresses,
+// upper-cased MACs, reordered lists, ...) and provides the schema helpers suppressing the resulting diffs.
+package normalize
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * Function used to build a Diff Suppress Function ignoring differences that vanish once both values are normalized
+ */
+func SuppressEquivalent(normalize func(value string) string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+
+		// Compare Normalized Values
+		return normalize(old) == normalize(new)
+	}
+}
+
+/**
+ * Function used to build a State Function storing the normalized value of a String Attribute
+ */
+func StateFunc(normalize func(value string) string) schema.SchemaStateFunc {
+	return func(v interface{}) string {
+
+		// Get String Value
+		value, _ := v.(string)
+
+		// Return Normalized Value
+		return normalize(value)
+	}
+}
+
+/**
+ * Function used to Normalize an IP Address, with an optional Prefix Length, the way RouterOS prints it
+ * (e.g. 2001:0DB8:0:0::1/64 becomes 2001:db8::1/64). Values that do not parse are returned as is.
+ */
+func IpAddress(value string) string {
+
+	// Split Address and Prefix Length
+	address, prefix := value, ""
+	if index := strings.Index(value, "/"); index >= 0 {
+		address, prefix = value[:index], value[index:]
+	}
+
+	// Parse Address
+	ip := net.ParseIP(address)
+
+	// If Value is not an Address
+	if ip == nil {
+
+		// Return Value as is
+		return value
+	}
+
+	// Return Canonical Address
+	return ip.String() + prefix
+}
+
+/**
+ * Function used to Normalize an Address List Entry the way RouterOS prints it: host prefixes (/32 or /128)
+ * become addresses and other prefixes their network (e.g. 10.0.0.5/24 becomes 10.0.0.0/24). Ranges and
+ * DNS names are returned as is.
+ */
+func ListAddress(value string) string {
+
+	// Parse Prefix
+	ip, network, err := net.ParseCIDR(value)
+
+	// If Value is not a Prefix
+	if err != nil {
+
+		// Return Canonical Address, or Value as is
+		return IpAddress(value)
+	}
+
+	// If Prefix is a single Host
+	if ones, bits := network.Mask.Size(); ones == bits {
+
+		// Return Address
+		return ip.String()
+	}
+
+	// Return Network
+	return network.String()
+}
+
+/**
+ * Function used to Normalize a MAC Address the way RouterOS prints it (upper-cased, colon separated)
+ */
+func MacAddress(value string) string {
+
+	// Return Canonical MAC Address
+	return strings.ToUpper(strings.ReplaceAll(value, "-", ":"))
+}
+
+/**
+ * Function used to Normalize a Bridge Port Horizon: RouterOS may print an unset Horizon as an empty value
+ */
+func Horizon(value string) string {
+
+	// If Horizon is unset
+	if value == "" {
+
+		// Return RouterOS Keyword
+		return "none"
+	}
+
+	// Return Value
+	return value
+}
+
+/**
+ * Function used to Normalize a Boolean written the RouterOS way (yes or no) to true or false. Other values
+ * are returned as is.
+ */
+func Bool(value string) string {
+
+	// Map RouterOS Keywords
+	switch value {
+	case "yes":
+		return "true"
+	case "no":
+		return "false"
+	}
+
+	// Return Value
+	return value
+}
+
+// durationUnits matches the Units of a RouterOS Duration (e.g. 1w2d3h4m5s) and durationClock its Clock form (e.g. 1d02:00:00)
+var (
+	durationUnits = regexp.MustCompile(`^(?:(\d+)w)?(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?$`)
+	durationClock = regexp.MustCompile(`^(?:(\d+)w)?(?:(\d+)d)?(\d+):(\d{2}):(\d{2})$`)
+)
+
+/**
+ * Function used to Normalize a RouterOS Duration to its Seconds, as RouterOS rewrites Durations to its
+ * own Units (e.g. 7d becomes 1w, 00:30:00 becomes 30m). Values that do not parse are returned as is.
+ */
+func Duration(value string) string {
+
+	// Initialize Units (weeks, days, hours, minutes and seconds)
+	var units []string
+	if match := durationUnits.FindStringSubmatch(value); match != nil && value != "" {
+		units = match[1:]
+	} else if match := durationClock.FindStringSubmatch(value); match != nil {
+		units = match[1:]
+	} else {
+
+		// Return Value as is
+		return value
+	}
+
+	// Sum Seconds
+	seconds := 0
+	for i, multiplier := range []int{604800, 86400, 3600, 60, 1} {
+		count, _ := strconv.Atoi(units[i])
+		seconds += count * multiplier
+	}
+
+	// Return Canonical Duration
+	return strconv.Itoa(seconds) + "s"
+}
+
+/**
+ * Function used to Normalize a Range of RouterOS Durations, each Bound to its Seconds (e.g. 3m20s-10m becomes 200s-600s)
+ */
+func DurationRange(value string) string {
+
+	// Normalize Bounds
+	bounds := strings.SplitN(value, "-", 2)
+	for i, bound := range bounds {
+		bounds[i] = Duration(bound)
+	}
+
+	// Return Canonical Range
+	return strings.Join(bounds, "-")
+}
+
+/**
+ * Function used to Normalize a Comma Separated List whose order RouterOS does not keep (e.g. enc-algorithms)
+ */
+func CommaList(value string) string {
+
+	// Initialize Items
+	items := []string{}
+
+	// For each non Empty Item
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	// Sort Items
+	sort.Strings(items)
+
+	// Return Canonical List
+	return strings.Join(items, ",")
+}
+
+/**
+ * Function used to Order the Items read from RouterOS like the Items already known (State or Configuration),
+ * so that a List RouterOS reorders (e.g. script policy) only differs when its content does
+ */
+func OrderLike(known []string, actual []string) []string {
+
+	// If Lists do not hold the same Items
+	if CommaList(strings.Join(known, ",")) != CommaList(strings.Join(actual, ",")) {
+
+		// Return Items read from RouterOS
+		return actual
+	}
+
+	// Return Known Order
+	return known
+}
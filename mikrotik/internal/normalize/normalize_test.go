@@ -0,0 +1,70 @@
+package normalize
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizers(t *testing.T) {
+	cases := []struct {
+		name      string
+		normalize func(value string) string
+		value     string
+		expected  string
+	}{
+		{"ipv6 address", IpAddress, "2001:0DB8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{"ipv6 prefix", IpAddress, "2001:db8:0:0::1/64", "2001:db8::1/64"},
+		{"ipv4 prefix", IpAddress, "10.0.0.1/24", "10.0.0.1/24"},
+		{"not an address", IpAddress, "router.lan", "router.lan"},
+		{"list host prefix", ListAddress, "10.0.0.1/32", "10.0.0.1"},
+		{"list ipv6 host prefix", ListAddress, "2001:DB8::1/128", "2001:db8::1"},
+		{"list prefix", ListAddress, "10.0.0.5/24", "10.0.0.0/24"},
+		{"list range", ListAddress, "10.0.0.1-10.0.0.9", "10.0.0.1-10.0.0.9"},
+		{"list dns name", ListAddress, "host.lan", "host.lan"},
+		{"mac address", MacAddress, "74:4d:28:f3:a7:16", "74:4D:28:F3:A7:16"},
+		{"dashed mac address", MacAddress, "74-4d-28-f3-a7-16", "74:4D:28:F3:A7:16"},
+		{"unset horizon", Horizon, "", "none"},
+		{"horizon", Horizon, "3", "3"},
+		{"routeros true", Bool, "yes", "true"},
+		{"routeros false", Bool, "no", "false"},
+		{"bool", Bool, "true", "true"},
+		{"duration", Duration, "7d", "604800s"},
+		{"duration units", Duration, "1w", "604800s"},
+		{"duration clock", Duration, "1d02:00:00", "93600s"},
+		{"not a duration", Duration, "none", "none"},
+		{"duration range", DurationRange, "3m20s-10m", "200s-600s"},
+		{"comma list", CommaList, "aes-256-cbc, aes-128-cbc,3des", "3des,aes-128-cbc,aes-256-cbc"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.normalize(tc.value); actual != tc.expected {
+				t.Errorf("The normalized value does not match what we expected. actual: %s expected: %s", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalent(t *testing.T) {
+	suppress := SuppressEquivalent(CommaList)
+
+	if !suppress("enc_algorithms", "aes-128-cbc,aes-256-cbc", "aes-256-cbc,aes-128-cbc", nil) {
+		t.Error("expected reordered lists to be suppressed")
+	}
+	if suppress("enc_algorithms", "aes-128-cbc", "aes-256-cbc,aes-128-cbc", nil) {
+		t.Error("expected different lists not to be suppressed")
+	}
+}
+
+func TestOrderLike(t *testing.T) {
+	known := []string{"write", "read", "test"}
+
+	if actual := OrderLike(known, []string{"read", "write", "test"}); !reflect.DeepEqual(actual, known) {
+		t.Errorf("expected known order to be kept, got: %v", actual)
+	}
+
+	changed := []string{"read", "write"}
+	if actual := OrderLike(known, changed); !reflect.DeepEqual(actual, changed) {
+		t.Errorf("expected actual items when the content differs, got: %v", actual)
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	mt "github.com/kube-cloud/terraform-provider-mikrotik/client"
 )
 
@@ -31,14 +34,14 @@ func Provider(client *mt.Mikrotik) *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"host": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_HOST", nil),
-				Description: "Hostname of the MikroTik router",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_HOST", ""),
+				Description: "Hostname or IP address (IPv6 with or without brackets, optionally with the port) of the MikroTik router managing resources without `device`",
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_USER", nil),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_USER", ""),
 				Description: "User account for MikroTik api",
 			},
 			"password": {
@@ -47,10 +50,22 @@ func Provider(client *mt.Mikrotik) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_PASSWORD", ""),
 				Description: "Password for MikroTik api",
 			},
+			"password_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_PASSWORD_FILE", ""),
+				Description: "Path to a file holding the password for MikroTik api, used when `password` is empty",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_PROFILE", ""),
+				Description: "Profile of the credentials file filling the connection settings left empty. The `default` profile, if any, when empty",
+			},
 			"tls": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_TLS", false),
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_TLS", nil),
 				Description: "Whether to use TLS when connecting to MikroTik or not",
 			},
 			"ca_certificate": {
@@ -62,39 +77,139 @@ func Provider(client *mt.Mikrotik) *schema.Provider {
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_INSECURE", false),
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_INSECURE", nil),
 				Description: "Insecure connection does not verify MikroTik's TLS certificate",
 			},
+			"port": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MIKROTIK_PORT", 0),
+				ValidateDiagFunc: validatePort,
+				Description:      "API port of the router, 8728 (or 8729 with `tls`) when neither set here nor in `host`",
+			},
+			"connect_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MIKROTIK_CONNECT_TIMEOUT", ""),
+				ValidateDiagFunc: validateString(checkGoDuration),
+				Description:      "Time allowed to connect, negotiate TLS and log in to a router (e.g. `10s`). Unbounded when empty",
+			},
+			"keepalive": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MIKROTIK_KEEPALIVE", ""),
+				ValidateDiagFunc: validateString(checkGoDuration),
+				Description:      "TCP keepalive period of router connections (e.g. `30s`), `0s` disables keepalives. The operating system default when empty",
+			},
+			"bind_address": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MIKROTIK_BIND_ADDRESS", ""),
+				ValidateDiagFunc: validateString(checkOptional(checkIpAddress(ipAnyFamily))),
+				Description:      "Source address of router connections",
+			},
+			"ca_certificate_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_CA_CERTIFICATE_PEM", ""),
+				Description: "PEM encoded certificate authority, trusted along with `ca_certificate`",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_CLIENT_CERTIFICATE", ""),
+				Description: "Path to, or PEM contents of, the client certificate presented to routers requiring one",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_CLIENT_KEY", ""),
+				Description: "Path to, or PEM contents of, the private key of `client_certificate`",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_TLS_SERVER_NAME", ""),
+				Description: "Name the router certificate is verified against, when it differs from `host`",
+			},
+			"tls_min_version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MIKROTIK_TLS_MIN_VERSION", ""),
+				ValidateDiagFunc: validateEnum("1.0", "1.1", "1.2", "1.3"),
+				Description:      "Lowest TLS version accepted from the router (1.0, 1.1, 1.2 or 1.3)",
+			},
+			"cache_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MIKROTIK_CACHE_READS", false),
+				Description: "Fetch each menu once with a single print and serve reads from it, speeding up refreshes of many resources. A menu is read from the router again once it is written",
+			},
+			"devices": devicesSchema(),
+			"connectivity_guard": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MIKROTIK_CONNECTIVITY_GUARD", 0),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 1440)),
+				Description:      "Minutes after which the router restores the backup saved before the first change of an apply, unless the provider can reconnect to it once the running changes are done. Guards against applies that lock the provider out. Disabled when 0",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"mikrotik_bgp_instance":          resourceBgpInstance(),
 			"mikrotik_bgp_peer":              resourceBgpPeer(),
+			"mikrotik_dhcp_client":           resourceDhcpClient(),
 			"mikrotik_dhcp_lease":            resourceLease(),
+			"mikrotik_dhcp_lease_table":      resourceDhcpLeaseTable(),
+			"mikrotik_dhcp_option":           resourceDhcpOption(),
+			"mikrotik_dhcp_option_set":       resourceDhcpOptionSet(),
+			"mikrotik_dhcp_relay":            resourceDhcpRelay(),
 			"mikrotik_dhcp_server_network":   resourceDhcpServerNetwork(),
 			"mikrotik_dhcp_server":           resourceDhcpServer(),
+			"mikrotik_dhcpv6_client":         resourceDhcpv6Client(),
+			"mikrotik_dhcpv6_server":         resourceDhcpv6Server(),
+			"mikrotik_dns":                   resourceDns(),
 			"mikrotik_dns_record":            resourceRecord(),
 			"mikrotik_interface_list_member": resourceInterfaceListMember(),
 			"mikrotik_interface_list":        resourceInterfaceList(),
 			"mikrotik_ip_address":            resourceIpAddress(),
+			"mikrotik_ip_arp":                resourceIpArp(),
 			"mikrotik_ipv6_address":          resourceIpv6Address(),
+			"mikrotik_ipv6_nd":               resourceIpv6Nd(),
+			"mikrotik_ipv6_pool":             resourceIpv6Pool(),
 			"mikrotik_pool":                  resourcePool(),
 			"mikrotik_scheduler":             resourceScheduler(),
 			"mikrotik_script":                resourceScript(),
+			"mikrotik_system_backup":         resourceSystemBackup(),
 			"mikrotik_vlan_interface":        resourceVlanInterface(),
 			"mikrotik_bridge_interface":      resourceBridgeInterface(),
 			"mikrotik_bridge_interface_port": resourceBridgeInterfacePort(),
+			"mikrotik_bridge_ports":          resourceBridgePorts(),
 			"mikrotik_ipsec_proposal":        resourceIpSecProposal(),
 			"mikrotik_ipsec_profile":         resourceIpSecProfile(),
 			"mikrotik_ipsec_peer":            resourceIpSecPeer(),
 			"mikrotik_ipsec_identity":        resourceIpSecIdentity(),
 			"mikrotik_ipsec_policy_group":    resourceIpSecPolicyGroup(),
 			"mikrotik_ipsec_policy":          resourceIpSecPolicy(),
+			"mikrotik_firewall_address_list": resourceFirewallAddressList(),
 			"mikrotik_firewall_rule":         resourceFirewallRule(),
 			"mikrotik_firewall_nat":          resourceFirewallNat(),
 			"mikrotik_firewall_mangle":       resourceFirewallMangle(),
 			"mikrotik_firewall_raw":          resourceFirewallRaw(),
 			"mikrotik_tftp":                  resourceTftp(),
 		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"mikrotik_arp_table":       dataSourceArpTable(),
+			"mikrotik_pool_allocation": dataSourcePoolAllocation(),
+			"mikrotik_system_export":   dataSourceSystemExport(),
+		},
+	}
+
+	for _, resource := range provider.ResourcesMap {
+		withDevice(resource)
+	}
+	for _, dataSource := range provider.DataSourcesMap {
+		dataSourceWithDevice(dataSource)
 	}
 
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -102,19 +217,64 @@ func Provider(client *mt.Mikrotik) *schema.Provider {
 			return client, nil
 		}
 
-		address := d.Get("host").(string)
-		username := d.Get("username").(string)
-		password := d.Get("password").(string)
-		tls := d.Get("tls").(bool)
-		caCertificate := d.Get("ca_certificate").(string)
-		insecure := d.Get("insecure").(bool)
+		devices, err := configureDevices(d, func(c *mt.Mikrotik) error {
+			if c.TLS {
+				if _, err := c.TLSConfig(); err != nil {
+					return err
+				}
+			}
+			if d.Get("cache_reads").(bool) {
+				c.EnableCache()
+			}
+			if minutes := d.Get("connectivity_guard").(int); minutes > 0 {
+				c.EnableConnectivityGuard(time.Duration(minutes) * time.Minute)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if d.Get("connectivity_guard").(int) > 0 {
+			registerGuardedDevices(devices)
+		}
 
-		return mt.NewClient(address, username, password, tls, caCertificate, insecure), nil
+		return devices, nil
 	}
 
 	return provider
 }
 
+var guardedDevices struct {
+	sync.Mutex
+	devices []*mt.Devices
+}
+
+func registerGuardedDevices(devices *mt.Devices) {
+	guardedDevices.Lock()
+	defer guardedDevices.Unlock()
+	guardedDevices.devices = append(guardedDevices.devices, devices)
+}
+
+// RemoveConnectivityGuards removes the backup and script the connectivity guard left on every
+// router once the apply is done. The rollbacks were already cancelled when the changes ended.
+func RemoveConnectivityGuards() error {
+	guardedDevices.Lock()
+	defer guardedDevices.Unlock()
+
+	var errs []string
+	for _, devices := range guardedDevices.devices {
+		if err := devices.RemoveConnectivityGuards(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	guardedDevices.devices = nil
+
+	if len(errs) > 0 {
+		return fmt.Errorf("connectivity guard: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 func NewProvider() *schema.Provider {
 	return Provider(nil)
 }
@@ -3,7 +3,6 @@ package mikrotik
 import (
 	"context"
 	"fmt"
-	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,7 +23,7 @@ var testAccProvider *schema.Provider
 var apiClient *client.Mikrotik
 
 func init() {
-	apiClient = client.NewClient(os.Getenv("MIKROTIK_HOST"), os.Getenv("MIKROTIK_USER"), os.Getenv("MIKROTIK_PASSWORD"), false, "", true)
+	apiClient = client.NewClient(client.GetConfigFromEnv())
 
 	testAccProvider = Provider(apiClient)
 	testAccProviderFactories = map[string]func() (*schema.Provider, error){
@@ -33,14 +32,11 @@ func init() {
 }
 
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("MIKROTIK_HOST"); v == "" {
-		t.Fatal("The MIKROTIK_HOST environment variable must be set")
+	if apiClient.Host == "" {
+		t.Fatal("The MIKROTIK_HOST environment variable or the host of the credentials profile must be set")
 	}
-	if v := os.Getenv("MIKROTIK_USER"); v == "" {
-		t.Fatal("The MIKROTIK_USER environment variable must be set")
-	}
-	if _, exists := os.LookupEnv("MIKROTIK_PASSWORD"); !exists {
-		t.Fatal("The MIKROTIK_PASSWORD environment variable must be set")
+	if apiClient.Username == "" {
+		t.Fatal("The MIKROTIK_USER environment variable or the username of the credentials profile must be set")
 	}
 }
 
@@ -9,7 +9,7 @@ import (
 )
 
 func resourceBgpInstance() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Creates a Mikrotik BGP Instance.",
 
 		CreateContext: resourceBgpInstanceCreate,
@@ -17,14 +17,17 @@ func resourceBgpInstance() *schema.Resource {
 		UpdateContext: resourceBgpInstanceUpdate,
 		DeleteContext: resourceBgpInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/routing/bgp/instance", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the BGP instance.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "The name of the BGP instance.",
 			},
 			"as": {
 				Type:        schema.TypeInt,
@@ -43,9 +46,10 @@ func resourceBgpInstance() *schema.Resource {
 				Description: "The comment of the BGP instance to be created.",
 			},
 			"confederation_peers": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "List of AS numbers internal to the [local] confederation. For example: `10,20,30-50`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNumberSet(0, 4294967295),
+				Description:      "List of AS numbers internal to the [local] confederation. For example: `10,20,30-50`.",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -95,9 +99,10 @@ func resourceBgpInstance() *schema.Resource {
 				Description: " If enabled, the router will redistribute the information about static routes added to its routing database.",
 			},
 			"router_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: " 	BGP Router ID (for this instance). If set to 0.0.0.0, BGP will use one of router's IP addresses.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpv4Address,
+				Description:      " 	BGP Router ID (for this instance). If set to 0.0.0.0, BGP will use one of router's IP addresses.",
 			},
 			"routing_table": {
 				Type:        schema.TypeString,
@@ -106,9 +111,10 @@ func resourceBgpInstance() *schema.Resource {
 				Description: "Name of routing table this BGP instance operates on. ",
 			},
 			"cluster_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: " 	In case this instance is a route reflector: cluster ID of the router reflector cluster this instance belongs to.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateString(checkOptional(checkIpAddress(ipv4Family))),
+				Description:      " 	In case this instance is a route reflector: cluster ID of the router reflector cluster this instance belongs to.",
 			},
 			"confederation": {
 				Type:        schema.TypeInt,
@@ -117,6 +123,12 @@ func resourceBgpInstance() *schema.Resource {
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceBgpInstanceV0(), "/routing/bgp/instance", "name"),
+	}
+
+	return resource
 }
 
 func resourceBgpInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -141,12 +153,8 @@ func resourceBgpInstanceRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return bgpInstanceToData(bgpInstance, d)
@@ -155,13 +163,8 @@ func resourceBgpInstanceRead(ctx context.Context, d *schema.ResourceData, m inte
 func resourceBgpInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 
-	currentBgpInstance, err := c.FindBgpInstance(d.Get("name").(string))
-	if _, ok := err.(client.LegacyBgpUnsupported); ok {
-		return diag.FromErr(err)
-	}
-
 	instance := prepareBgpInstance(d)
-	instance.ID = currentBgpInstance.ID
+	instance.ID = d.Id()
 
 	bgpInstance, err := c.UpdateBgpInstance(instance)
 
@@ -175,13 +178,13 @@ func resourceBgpInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 func resourceBgpInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 
-	err := c.DeleteBgpInstance(d.Get("name").(string))
+	err := c.DeleteBgpInstance(d.Id())
 	if _, ok := err.(client.LegacyBgpUnsupported); ok {
 		return diag.FromErr(err)
 	}
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	d.SetId("")
@@ -209,7 +212,7 @@ func bgpInstanceToData(b *client.BgpInstance, d *schema.ResourceData) diag.Diagn
 		"confederation":               b.Confederation,
 	}
 
-	d.SetId(b.Name)
+	d.SetId(b.ID)
 
 	var diags diag.Diagnostics
 
@@ -41,7 +41,7 @@ func TestAccMikrotikBgpInstance_create(t *testing.T) {
 }
 
 func TestAccMikrotikBgpInstance_createFailsOnRouterOSv7(t *testing.T) {
-	if client.IsLegacyBgpSupported() {
+	if client.IsLegacyBgpSupported(t) {
 		t.Skip()
 	}
 
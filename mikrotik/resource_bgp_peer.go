@@ -6,10 +6,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceBgpPeer() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Creates a MikroTik BGP Peer.",
 
 		CreateContext: resourceBgpPeerCreate,
@@ -17,14 +18,17 @@ func resourceBgpPeer() *schema.Resource {
 		UpdateContext: resourceBgpPeerUpdate,
 		DeleteContext: resourceBgpPeerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/routing/bgp/peer", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the BGP peer.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "The name of the BGP peer.",
 			},
 			"remote_as": {
 				Type:        schema.TypeInt,
@@ -32,44 +36,53 @@ func resourceBgpPeer() *schema.Resource {
 				Description: "The 32-bit AS number of the remote peer.",
 			},
 			"remote_address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The address of the remote peer",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpAddress,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "The address of the remote peer",
 			},
 			"instance": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the instance this peer belongs to. See Mikrotik bgp instance resource.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "The name of the instance this peer belongs to. See Mikrotik bgp instance resource.",
 			},
 			"address_families": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "ip",
-				Description: "The list of address families about which this peer will exchange routing information.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ip",
+				ValidateDiagFunc: validateEnumList("ip", "ipv6", "l2vpn", "l2vpn-cisco", "vpnv4", "vpnv6"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.CommaList),
+				Description:      "The list of address families about which this peer will exchange routing information.",
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "default",
-				Description: "Time To Live, the hop limit for TCP connection. This is a `string` field that can be 'default' or '0'-'255'.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "default",
+				ValidateDiagFunc: validateNumberOrKeyword(0, 255, "default"),
+				Description:      "Time To Live, the hop limit for TCP connection. This is a `string` field that can be 'default' or '0'-'255'.",
 			},
 			"default_originate": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "never",
-				Description: "The comment of the BGP peer to be created.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "never",
+				ValidateDiagFunc: validateEnum("always", "if-installed", "never"),
+				Description:      "The comment of the BGP peer to be created.",
 			},
 			"hold_time": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "3m",
-				Description: "Specifies the BGP Hold Time value to use when negotiating with peer",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "3m",
+				ValidateDiagFunc: validateDuration("infinity"),
+				Description:      "Specifies the BGP Hold Time value to use when negotiating with peer",
 			},
 			"nexthop_choice": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "default",
-				Description: "Affects the outgoing NEXT_HOP attribute selection, either: 'default', 'force-self', or 'propagate'",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "default",
+				ValidateDiagFunc: validateEnum("default", "force-self", "propagate"),
+				Description:      "Affects the outgoing NEXT_HOP attribute selection, either: 'default', 'force-self', or 'propagate'",
 			},
 			"out_filter": {
 				Type:        schema.TypeString,
@@ -109,8 +122,9 @@ func resourceBgpPeer() *schema.Resource {
 				Description: "Whether peer is disabled.",
 			},
 			"keepalive_time": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDuration(),
 			},
 			"max_prefix_limit": {
 				Type:        schema.TypeInt,
@@ -118,9 +132,10 @@ func resourceBgpPeer() *schema.Resource {
 				Description: "Maximum number of prefixes to accept from a specific peer.",
 			},
 			"max_prefix_restart_time": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Minimum time interval after which peers can reestablish BGP session.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDuration("infinity"),
+				Description:      "Minimum time interval after which peers can reestablish BGP session.",
 			},
 			"multihop": {
 				Type:        schema.TypeBool,
@@ -135,9 +150,10 @@ func resourceBgpPeer() *schema.Resource {
 				Description: "Name of the routing filter chain that is applied to the outgoing routing information.",
 			},
 			"remote_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Remote peers port to establish tcp session.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validatePort,
+				Description:      "Remote peers port to establish tcp session.",
 			},
 			"remove_private_as": {
 				Type:        schema.TypeBool,
@@ -169,6 +185,12 @@ func resourceBgpPeer() *schema.Resource {
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceBgpPeerV0(), "/routing/bgp/peer", "name"),
+	}
+
+	return resource
 }
 
 func resourceBgpPeerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -188,12 +210,8 @@ func resourceBgpPeerRead(ctx context.Context, d *schema.ResourceData, m interfac
 	c := m.(*client.Mikrotik)
 
 	bgpPeer, err := c.FindBgpPeer(d.Id())
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return bgpPeerToData(bgpPeer, d)
@@ -202,10 +220,8 @@ func resourceBgpPeerRead(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceBgpPeerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 
-	currentBgpPeer, err := c.FindBgpPeer(d.Get("name").(string))
-
 	peer := prepareBgpPeer(d)
-	peer.ID = currentBgpPeer.ID
+	peer.ID = d.Id()
 
 	bgpPeer, err := c.UpdateBgpPeer(peer)
 	if err != nil {
@@ -218,10 +234,10 @@ func resourceBgpPeerUpdate(ctx context.Context, d *schema.ResourceData, m interf
 func resourceBgpPeerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 
-	err := c.DeleteBgpPeer(d.Get("name").(string))
+	err := c.DeleteBgpPeer(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	d.SetId("")
@@ -259,7 +275,7 @@ func bgpPeerToData(b *client.BgpPeer, d *schema.ResourceData) diag.Diagnostics {
 		"use_bfd":                 b.UseBfd,
 	}
 
-	d.SetId(b.Name)
+	d.SetId(b.ID)
 
 	var diags diag.Diagnostics
 
@@ -71,11 +71,11 @@ func TestAccMikrotikBgpPeer_createAndPlanWithNonExistantBgpPeer(t *testing.T) {
 	removeBgpPeer := func() {
 
 		c := client.NewClient(client.GetConfigFromEnv())
-		bgpPeer, err := c.FindBgpPeer(name)
+		bgpPeer, err := c.FindBgpPeerByName(name)
 		if err != nil {
 			t.Fatalf("Error finding the bgp peer by name: %s", err)
 		}
-		err = c.DeleteBgpPeer(bgpPeer.Name)
+		err = c.DeleteBgpPeer(bgpPeer.ID)
 		if err != nil {
 			t.Fatalf("Error removing the bgp peer: %s", err)
 		}
@@ -6,10 +6,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceBridgeInterface() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Manages Bridge Network (VLAN) interfaces.",
 
 		CreateContext: resourceBridgeInterfaceCreate,
@@ -18,20 +19,24 @@ func resourceBridgeInterface() *schema.Resource {
 		DeleteContext: resourceBridgeInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/interface/bridge", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"mtu": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     1500,
-				Description: "Layer3 Maximum transmission unit.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1500,
+				ValidateDiagFunc: validateMtu,
+				Description:      "Layer3 Maximum transmission unit.",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Interface name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface name.",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -46,10 +51,19 @@ func resourceBridgeInterface() *schema.Resource {
 				Description: "Bridge Interface MAC Auto Selection Flag.",
 			},
 			"admin_mac": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "Bridge Interface Administration MAC.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateMacAddress,
+				StateFunc:        normalize.StateFunc(normalize.MacAddress),
+				Description:      "Bridge Interface Administration MAC.",
+			},
+			"arp": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "enabled",
+				ValidateDiagFunc: validateEnum(interfaceArpModes...),
+				Description:      "Address Resolution Protocol mode: `enabled`, `disabled`, `reply-only` (only answers for addresses pinned by static `mikrotik_ip_arp` entries), `proxy-arp` or `local-proxy-arp`.",
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -59,13 +73,19 @@ func resourceBridgeInterface() *schema.Resource {
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceBridgeInterfaceV0(), "/interface/bridge", "name"),
+	}
+
+	return resource
 }
 
 func resourceBridgeInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 	record, err := c.FindBridgeInterface(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return recordBridgeInterfaceToData(record, d)
@@ -78,7 +98,7 @@ func resourceBridgeInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(record.Name)
+	d.SetId(record.Id)
 
 	return resourceBridgeInterfaceRead(ctx, d, m)
 }
@@ -96,7 +116,6 @@ func resourceBridgeInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(record.Name)
 
 	return nil
 }
@@ -105,7 +124,7 @@ func resourceBridgeInterfaceDelete(ctx context.Context, d *schema.ResourceData,
 	c := m.(*client.Mikrotik)
 	err := c.DeleteBridgeInterface(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	return nil
@@ -118,6 +137,7 @@ func dataToBridgeInterface(d *schema.ResourceData) *client.BridgeInterface {
 		Disabled: d.Get("disabled").(bool),
 		AutoMac:  d.Get("auto_mac").(bool),
 		AdminMac: d.Get("admin_mac").(string),
+		Arp:      d.Get("arp").(string),
 		Comment:  d.Get("comment").(string),
 	}
 }
@@ -145,11 +165,15 @@ func recordBridgeInterfaceToData(r *client.BridgeInterface, d *schema.ResourceDa
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	if err := d.Set("arp", r.Arp); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	if err := d.Set("comment", r.Comment); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	d.SetId(r.Name)
+	d.SetId(r.Id)
 
 	return diags
 }
@@ -5,11 +5,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceBridgeInterfacePort() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Manages Bridge Network Interfaces Ports.",
 
 		CreateContext: resourceBridgeInterfacePortCreate,
@@ -18,31 +20,38 @@ func resourceBridgeInterfacePort() *schema.Resource {
 		DeleteContext: resourceBridgeInterfacePortDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/interface/bridge/port", "interface"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"bridge": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Bridge Interface.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Bridge Interface.",
 			},
 			"interface": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Network Interface Name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Network Interface Name.",
 			},
 			"horizon": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "none", // Other Values : 0, 1, 2, etc...
-				Description: "Bridge Port  Horizon (Values : none|Integer).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "none", // Other Values : 0, 1, 2, etc...
+				ValidateDiagFunc: validateNumberOrKeyword(0, 4294967295, "none"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Horizon),
+				Description:      "Bridge Port  Horizon (Values : none|Integer).",
 			},
 			"learn": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "auto", // Other Values : yes, no
-				Description: "Bridge Port  Learn (Values : auto|yes|no).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "auto", // Other Values : yes, no
+				ValidateDiagFunc: validateEnum("auto", "yes", "no"),
+				Description:      "Bridge Port  Learn (Values : auto|yes|no).",
 			},
 			"unknown_multicast_flood": {
 				Type:        schema.TypeBool,
@@ -99,28 +108,32 @@ func resourceBridgeInterfacePort() *schema.Resource {
 				Description: "Bridge Port BPDU Guard.",
 			},
 			"path_cost": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     10,
-				Description: "Bridge Port Path Cost.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 200000000)),
+				Description:      "Bridge Port Path Cost.",
 			},
 			"internal_path_cost": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     10,
-				Description: "Bridge Port Internal Path Cost.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 200000000)),
+				Description:      "Bridge Port Internal Path Cost.",
 			},
 			"edge": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "auto",
-				Description: "Bridge Port Edge (Values : auto|no|no-discover|yes|yes-discover).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "auto",
+				ValidateDiagFunc: validateEnum("auto", "no", "no-discover", "yes", "yes-discover"),
+				Description:      "Bridge Port Edge (Values : auto|no|no-discover|yes|yes-discover).",
 			},
 			"point_to_point": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "auto",
-				Description: "Bridge Port Point To Point (Values : auto|yes|no).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "auto",
+				ValidateDiagFunc: validateEnum("auto", "yes", "no"),
+				Description:      "Bridge Port Point To Point (Values : auto|yes|no).",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -136,13 +149,19 @@ func resourceBridgeInterfacePort() *schema.Resource {
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceBridgeInterfacePortV0(), "/interface/bridge/port", "interface"),
+	}
+
+	return resource
 }
 
 func resourceBridgeInterfacePortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 	record, err := c.FindBridgeInterfacePort(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return recordBridgeInterfacePortToData(record, d)
@@ -156,7 +175,7 @@ func resourceBridgeInterfacePortCreate(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(record.Interface)
+	d.SetId(record.Id)
 
 	return resourceBridgeInterfacePortRead(ctx, d, m)
 }
@@ -174,7 +193,6 @@ func resourceBridgeInterfacePortUpdate(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(record.Interface)
 
 	return nil
 }
@@ -183,7 +201,7 @@ func resourceBridgeInterfacePortDelete(ctx context.Context, d *schema.ResourceDa
 	c := m.(*client.Mikrotik)
 	err := c.DeleteBridgeInterfacePort(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	return nil
@@ -292,7 +310,7 @@ func recordBridgeInterfacePortToData(r *client.BridgeInterfacePort, d *schema.Re
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	d.SetId(r.Interface)
+	d.SetId(r.Id)
 
 	return diags
 }
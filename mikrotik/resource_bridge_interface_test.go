@@ -46,6 +46,26 @@ func TestBridgeInterface_basic(t *testing.T) {
 	})
 }
 
+func TestBridgeInterface_disappears(t *testing.T) {
+	resourceName := "mikrotik_bridge_interface.testacc"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBridgeInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeInterface(1500, "test-bridge-disappears", false, true, "", "test-comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBridgeInterfaceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceBridgeInterface(), resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceBridgeInterface(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccBridgeInterfaceExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -0,0 +1,303 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Define Bridge Ports Resource: every static Port of a Bridge, written in Batches
+ */
+func resourceBridgePorts() *schema.Resource {
+
+	// Build and Return Resource
+	return &schema.Resource{
+
+		// Resource Description
+		Description: "Manages every static port of a bridge within MikroTik device. Ports are added, updated and removed in pipelined batches. Use `mikrotik_bridge_interface_port` instead for ports with their own settings.",
+
+		// Create Resource Context Method CallBack
+		CreateContext: createBridgePorts,
+
+		// Read Resource Context Method CallBack
+		ReadContext: readBridgePorts,
+
+		// Update Resource Context Method CallBack
+		UpdateContext: updateBridgePorts,
+
+		// Delete Resource Context Method CallBack
+		DeleteContext: deleteBridgePorts,
+
+		// Define Resource State Context Importer
+		Importer: &schema.ResourceImporter{
+
+			// Define State Context (the ID is the Bridge Name)
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Define Resource Schema
+		Schema: map[string]*schema.Schema{
+			"bridge": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the bridge.",
+			},
+			"interfaces": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateName,
+				},
+				Description: "Names of the interfaces that are ports of the bridge.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comment of the ports.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the ports are disabled.",
+			},
+		},
+	}
+}
+
+/**
+ * Function used to Create Bridge Ports
+ */
+func createBridgePorts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Bridge Ports Client
+	c := m.(*client.Mikrotik)
+
+	// Add every Port in one Batch
+	_, err := c.AddBridgePorts(dataToBridgePortsEntries(d, dataToBridgePortsInterfaces(d)))
+
+	// Set ID (the added Ports are tracked even if others failed)
+	d.SetId(d.Get("bridge").(string))
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Reload Bridge Ports
+	return readBridgePorts(ctx, d, m)
+}
+
+/**
+ * Function used to Read Bridge Ports
+ */
+func readBridgePorts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Bridge Ports Client
+	c := m.(*client.Mikrotik)
+
+	// List Ports
+	entries, err := c.ListBridgePorts(d.Id())
+
+	// If Bridge has no static Port anymore
+	if err == nil && len(entries) == 0 {
+
+		// Report Ports as Not Found
+		err = client.NewNotFound(fmt.Sprintf("ports of bridge `%s` not found", d.Id()))
+	}
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return readError(d, err)
+	}
+
+	// Convert Ports to Resource Data and put it in Resource Pointer
+	bridgePortsToData(d.Id(), entries, d)
+
+	// Return Diagnostic
+	return nil
+}
+
+/**
+ * Function used to Update Bridge Ports: missing Interfaces are added, extra ones removed, and the kept
+ * ones updated when the Comment or the Disabled Flag changed
+ */
+func updateBridgePorts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Bridge Ports Client
+	c := m.(*client.Mikrotik)
+
+	// List current Ports
+	entries, err := c.ListBridgePorts(d.Id())
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Get wanted Interfaces
+	wanted := map[string]bool{}
+	for _, iface := range dataToBridgePortsInterfaces(d) {
+		wanted[iface] = true
+	}
+
+	// Sort current Ports into Ports to Remove and Ports to Update
+	removed := []string{}
+	updated := []client.BridgePortsEntry{}
+	comment, disabled := d.Get("comment").(string), d.Get("disabled").(bool)
+	for _, entry := range entries {
+		switch {
+		case !wanted[entry.Interface]:
+			removed = append(removed, entry.Id)
+		case entry.Comment != comment || entry.Disabled != disabled:
+			entry.Comment, entry.Disabled = comment, disabled
+			updated = append(updated, entry)
+		}
+		delete(wanted, entry.Interface)
+	}
+
+	// Get Interfaces to Add
+	added := []string{}
+	for iface := range wanted {
+		added = append(added, iface)
+	}
+	sort.Strings(added)
+
+	// Remove Ports in one Batch (first, an Interface can only be a Port of one Bridge)
+	if err := c.DeleteBridgePorts(removed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Update Ports in one Batch
+	if err := c.UpdateBridgePorts(updated); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Add Ports in one Batch
+	if _, err := c.AddBridgePorts(dataToBridgePortsEntries(d, added)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Reload Bridge Ports
+	return readBridgePorts(ctx, d, m)
+}
+
+/**
+ * Function used to Delete Bridge Ports
+ */
+func deleteBridgePorts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Bridge Ports Client
+	c := m.(*client.Mikrotik)
+
+	// List Ports
+	entries, err := c.ListBridgePorts(d.Id())
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return deleteError(d, err)
+	}
+
+	// Collect Port IDs
+	ids := []string{}
+	for _, entry := range entries {
+		ids = append(ids, entry.Id)
+	}
+
+	// Remove every Port in one Batch
+	if err := c.DeleteBridgePorts(ids); err != nil {
+
+		// Return Error
+		return deleteError(d, err)
+	}
+
+	// Return Diagnostic
+	return nil
+}
+
+/**
+ * Function used to get the sorted Interfaces of the Resource Data
+ */
+func dataToBridgePortsInterfaces(d *schema.ResourceData) []string {
+
+	// Initialize Interfaces
+	interfaces := []string{}
+
+	// For each Interface
+	for _, iface := range d.Get("interfaces").(*schema.Set).List() {
+		interfaces = append(interfaces, iface.(string))
+	}
+
+	// Sort Interfaces (Batches are then run in a stable Order)
+	sort.Strings(interfaces)
+
+	// Return Interfaces
+	return interfaces
+}
+
+/**
+ * Function used to build the Ports of the given Interfaces from the Resource Data
+ */
+func dataToBridgePortsEntries(d *schema.ResourceData, interfaces []string) []client.BridgePortsEntry {
+
+	// Initialize Ports
+	entries := []client.BridgePortsEntry{}
+
+	// For each Interface
+	for _, iface := range interfaces {
+		entries = append(entries, client.BridgePortsEntry{
+			Bridge:    d.Get("bridge").(string),
+			Interface: iface,
+			Comment:   d.Get("comment").(string),
+			Disabled:  d.Get("disabled").(bool),
+		})
+	}
+
+	// Return Ports
+	return entries
+}
+
+/**
+ * Function used to put the Ports of a Bridge in the Resource Data
+ */
+func bridgePortsToData(bridge string, entries []client.BridgePortsEntry, d *schema.ResourceData) {
+
+	// Collect Interfaces
+	interfaces := []interface{}{}
+	for _, entry := range entries {
+		interfaces = append(interfaces, entry.Interface)
+	}
+
+	// Get Comment and Disabled Flag (a Port that drifted is reported, so that the next Update fixes it)
+	comment, disabled := d.Get("comment").(string), d.Get("disabled").(bool)
+	for _, entry := range entries {
+		if entry.Comment != comment || entry.Disabled != disabled {
+			comment, disabled = entry.Comment, entry.Disabled
+			break
+		}
+	}
+
+	// Set Values
+	d.Set("bridge", bridge)
+	d.Set("interfaces", schema.NewSet(schema.HashString, interfaces))
+	d.Set("comment", comment)
+	d.Set("disabled", disabled)
+}
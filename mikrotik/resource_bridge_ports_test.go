@@ -0,0 +1,188 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Bridge Ports Resource Create, Update and Import Test
+ */
+func TestBridgePorts_CreateUpdateImport(t *testing.T) {
+
+	// Initialize Resource Name
+	resourceName := "mikrotik_bridge_ports.testacc"
+
+	// Initialize Interfaces (VLAN Interfaces created along with the Bridge)
+	interfaces := []string{"tf-acc-ports-1", "tf-acc-ports-2", "tf-acc-ports-3"}
+
+	// Initialize Updated Interfaces (removes one Port and adds another)
+	updatedInterfaces := []string{"tf-acc-ports-2", "tf-acc-ports-3", "tf-acc-ports-4"}
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Check destroy Callback
+		CheckDestroy: testAccCheckBridgePortsDestroy,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Resource
+				Config: testAccBridgePorts(interfaces, "created"),
+
+				// Check Test Result
+				Check: resource.ComposeTestCheckFunc(
+					testAccBridgePortsEntries(resourceName, len(interfaces), "created"),
+					resource.TestCheckResourceAttr(resourceName, "id", "tf-acc-ports"),
+					resource.TestCheckResourceAttr(resourceName, "interfaces.#", fmt.Sprint(len(interfaces))),
+				),
+			},
+			{
+				// Configure Updated Test Resource (removes, adds and updates Ports)
+				Config: testAccBridgePorts(updatedInterfaces, "updated"),
+
+				// Check Test Result
+				Check: resource.ComposeTestCheckFunc(
+					testAccBridgePortsEntries(resourceName, len(updatedInterfaces), "updated"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "updated"),
+				),
+			},
+			{
+				// Import Test Resource
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+/**
+ * Function used to Check the static Ports of the Bridge on the Router
+ */
+func testAccBridgePortsEntries(resourceName string, count int, comment string) resource.TestCheckFunc {
+
+	// Find and return result
+	return func(s *terraform.State) error {
+
+		// Find resource
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		// If not OK
+		if !ok {
+
+			// Return Not Found Error Message
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		// Build Client
+		c := client.NewClient(client.GetConfigFromEnv())
+
+		// List Ports
+		entries, err := c.ListBridgePorts(rs.Primary.ID)
+
+		// If there are Error
+		if err != nil {
+
+			// Return Formatted Error Message
+			return fmt.Errorf("Unable to get remote ports for %s: %v", resourceName, err)
+		}
+
+		// If Port Count does not match
+		if len(entries) != count {
+
+			// Return Formatted Message
+			return fmt.Errorf("expected %d ports in %s, got %d", count, rs.Primary.ID, len(entries))
+		}
+
+		// Check Comments
+		for _, entry := range entries {
+			if entry.Comment != comment {
+				return fmt.Errorf("port %s has comment `%s`, expected `%s`", entry.Interface, entry.Comment, comment)
+			}
+		}
+
+		// Return Null
+		return nil
+	}
+}
+
+/**
+ * Function used to Test if Terraform Resource is Destroyed
+ */
+func testAccCheckBridgePortsDestroy(s *terraform.State) error {
+
+	// Build Client
+	c := client.NewClient(client.GetConfigFromEnv())
+
+	// Iterate over Resources
+	for _, rs := range s.RootModule().Resources {
+
+		// If Resource is not Bridge Ports
+		if rs.Type != "mikrotik_bridge_ports" {
+
+			// Continue Iteration
+			continue
+		}
+
+		// List Ports
+		entries, err := c.ListBridgePorts(rs.Primary.ID)
+
+		// If there is Error
+		if err != nil {
+
+			// Return Error
+			return err
+		}
+
+		// If Ports Exist
+		if len(entries) > 0 {
+
+			// Return Formatted Error
+			return fmt.Errorf("remote bridge (%s) still has %d ports", rs.Primary.ID, len(entries))
+		}
+	}
+
+	// Return nil
+	return nil
+}
+
+/**
+ * Function used to build Terraform Resource Configuration
+ */
+func testAccBridgePorts(interfaces []string, comment string) string {
+
+	// Return Resource Configuration
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "testacc" {
+	name = "tf-acc-ports"
+}
+
+resource "mikrotik_vlan_interface" "testacc" {
+	for_each  = toset(["tf-acc-ports-1", "tf-acc-ports-2", "tf-acc-ports-3", "tf-acc-ports-4"])
+	interface = "ether1"
+	name      = each.key
+	vlan_id   = 3900 + tonumber(substr(each.key, -1, 1))
+}
+
+resource "mikrotik_bridge_ports" "testacc" {
+	bridge     = mikrotik_bridge_interface.testacc.name
+	interfaces = ["%s"]
+	comment    = %q
+
+	depends_on = [mikrotik_vlan_interface.testacc]
+}
+`, strings.Join(interfaces, `", "`), comment)
+}
@@ -0,0 +1,171 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func resourceDhcpClient() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a DHCP client, leasing the address of an interface (usually the WAN facing one) from an upstream DHCP server.",
+
+		CreateContext: resourceDhcpClientCreate,
+		ReadContext:   resourceDhcpClientRead,
+		UpdateContext: resourceDhcpClientUpdate,
+		DeleteContext: resourceDhcpClientDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ip/dhcp-client", "interface"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"interface": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface the client leases an address for.",
+			},
+			"add_default_route": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "yes",
+				ValidateDiagFunc: validateEnum("yes", "no", "special-classless"),
+				Description:      "Whether a default route through the received gateway is added (`yes`), not (`no`), or added along the classless routes of option 121 (`special-classless`).",
+			},
+			"default_route_distance": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 255)),
+				Description:      "Distance of the default route added.",
+			},
+			"use_peer_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the DNS servers received are used by the router.",
+			},
+			"use_peer_ntp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the NTP servers received are used by the router.",
+			},
+			"script": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Script run when a lease is acquired or lost, with the `bound`, `server-address`, `lease-address` and `gateway-address` variables.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the client is disabled.",
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Address leased, with its prefix length (e.g. `192.0.2.10/24`). Empty until the client is bound.",
+			},
+			"gateway": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway received with the lease.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the client at the last refresh (e.g. `searching...`, `requesting...`, `bound`).",
+			},
+		},
+	}
+}
+
+func resourceDhcpClientCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddDhcpClient(dataToDhcpClient(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceDhcpClientRead(ctx, d, m)
+}
+
+func resourceDhcpClientRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindDhcpClient(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return dhcpClientToData(record, d)
+}
+
+func resourceDhcpClientUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateDhcpClient(dataToDhcpClient(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDhcpClientRead(ctx, d, m)
+}
+
+func resourceDhcpClientDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteDhcpClient(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+func dataToDhcpClient(d *schema.ResourceData) *client.DhcpClient {
+	return &client.DhcpClient{
+		Id:                   d.Id(),
+		Interface:            d.Get("interface").(string),
+		AddDefaultRoute:      d.Get("add_default_route").(string),
+		DefaultRouteDistance: d.Get("default_route_distance").(int),
+		UsePeerDns:           d.Get("use_peer_dns").(bool),
+		UsePeerNtp:           d.Get("use_peer_ntp").(bool),
+		Script:               d.Get("script").(string),
+		Disabled:             d.Get("disabled").(bool),
+		Comment:              d.Get("comment").(string),
+	}
+}
+
+func dhcpClientToData(r *client.DhcpClient, d *schema.ResourceData) diag.Diagnostics {
+	values := map[string]interface{}{
+		"interface":              r.Interface,
+		"add_default_route":      r.AddDefaultRoute,
+		"default_route_distance": r.DefaultRouteDistance,
+		"use_peer_dns":           r.UsePeerDns,
+		"use_peer_ntp":           r.UsePeerNtp,
+		"script":                 r.Script,
+		"disabled":               r.Disabled,
+		"comment":                r.Comment,
+		"address":                r.Address,
+		"gateway":                r.Gateway,
+		"status":                 r.Status,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
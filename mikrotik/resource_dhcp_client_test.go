@@ -0,0 +1,75 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikDhcpClient_createAndUpdate(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-dhcp-client")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikDhcpClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpClient(name, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcp_client.wan", "interface", name),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_client.wan", "add_default_route", "no"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_client.wan", "default_route_distance", "1"),
+					resource.TestCheckResourceAttrSet("mikrotik_dhcp_client.wan", "status"),
+				),
+			},
+			{
+				Config: testAccDhcpClient(name, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcp_client.wan", "default_route_distance", "10"),
+				),
+			},
+			{
+				ResourceName:            "mikrotik_dhcp_client.wan",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+	})
+}
+
+func testAccDhcpClient(name string, distance int) string {
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "wan" {
+  name = %q
+}
+
+resource "mikrotik_dhcp_client" "wan" {
+  interface              = mikrotik_bridge_interface.wan.name
+  add_default_route      = "no"
+  default_route_distance = %d
+  use_peer_dns           = false
+  use_peer_ntp           = false
+}
+`, name, distance)
+}
+
+func testAccCheckMikrotikDhcpClientDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_dhcp_client" {
+			continue
+		}
+
+		_, err := c.FindDhcpClient(rs.Primary.ID)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
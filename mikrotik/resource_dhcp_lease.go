@@ -3,10 +3,12 @@ package mikrotik
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceLease() *schema.Resource {
@@ -18,19 +20,24 @@ func resourceLease() *schema.Resource {
 		UpdateContext: resourceLeaseUpdate,
 		DeleteContext: resourceLeaseDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaults(importStateByNaturalKey("/ip/dhcp-server/lease", ".id"), map[string]interface{}{
+				"adopt_dynamic": false,
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The IP address of the DHCP lease to be created.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpv4Address,
+				Description:      "The IP address of the DHCP lease to be created.",
 			},
 			"macaddress": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The MAC addreess of the DHCP lease to be created.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateMacAddress,
+				StateFunc:        normalize.StateFunc(normalize.MacAddress),
+				Description:      "The MAC addreess of the DHCP lease to be created.",
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -40,19 +47,79 @@ func resourceLease() *schema.Resource {
 			"hostname": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The hostname of the device",
+				Computed:    true,
+				Deprecated:  "The host name is sent by the DHCP client and cannot be set, the attribute will become read-only.",
+				Description: "The host name sent by the DHCP client.",
 			},
 			"blocked": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "false",
+				ValidateDiagFunc: validateEnum("true", "false", "yes", "no"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Bool),
+				Description:      "Whether to block access for this DHCP client (true|false, or yes|no).",
+			},
+			"dynamic": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Deprecated:  "Leases managed by the resource are static, the attribute will become read-only. Use `adopt_dynamic` to take over a dynamic lease.",
+				Description: "Whether the dhcp lease is static or dynamic. Dynamic leases are not guaranteed to continue to be assigned to that specific device.",
+			},
+			"server": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "all",
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the DHCP server the lease belongs to, `all` for a lease given by any server.",
+			},
+			"client_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "false",
-				Description: "Whether to block access for this DHCP client (true|false).",
+				Description: "Client identifier (option 61) the lease is given to, matched instead of the MAC address when set.",
 			},
-			"dynamic": {
+			"lease_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateDuration(),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Time the lease is given for (e.g. `1h`), the server `lease_time` when `0s`.",
+			},
+			"rate_limit": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Rate limit of the client, added as a simple queue (e.g. `10M/10M` for rx/tx).",
+			},
+			"address_lists": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateName,
+				},
+				Description: "Firewall address lists the leased address is added to.",
+			},
+			"dhcp_options": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateName,
+				},
+				Description: "Names of the DHCP options handed out with the lease, in addition to those of the network.",
+			},
+			"use_src_mac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the client is matched by the source MAC address of the requests instead of the one in the DHCP packet.",
+			},
+			"adopt_dynamic": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Whether the dhcp lease is static or dynamic. Dynamic leases are not guaranteed to continue to be assigned to that specific device. Defaults to false.",
+				Description: "Whether an existing dynamic lease of `macaddress` (on `server`) is made static and taken over on creation, instead of failing with a duplicate.",
 			},
 		},
 	}
@@ -63,6 +130,19 @@ func resourceLeaseCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	c := m.(*client.Mikrotik)
 
+	if d.Get("adopt_dynamic").(bool) {
+		lease, err := c.AdoptDhcpLease(normalize.MacAddress(dhcpLease.MacAddress), dhcpLease)
+		if lease != nil {
+			d.SetId(lease.Id)
+		}
+		if err == nil {
+			return leaseToData(lease, d)
+		}
+		if !client.IsNotFound(err) || lease != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	lease, err := c.AddDhcpLease(dhcpLease)
 	if err != nil {
 		return diag.FromErr(err)
@@ -76,12 +156,8 @@ func resourceLeaseRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	lease, err := c.FindDhcpLease(d.Id())
 
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	if lease == nil {
@@ -99,7 +175,6 @@ func resourceLeaseUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	dhcpLease.Id = d.Id()
 
 	lease, err := c.UpdateDhcpLease(dhcpLease)
-	lease.Dynamic = dhcpLease.Dynamic
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -114,7 +189,7 @@ func resourceLeaseDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	err := c.DeleteDhcpLease(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	d.SetId("")
@@ -129,6 +204,14 @@ func leaseToData(lease *client.DhcpLease, d *schema.ResourceData) diag.Diagnosti
 		"macaddress": lease.MacAddress,
 		"hostname":   lease.Hostname,
 		"dynamic":    lease.Dynamic,
+		"server":     lease.Server,
+		"client_id":  lease.ClientId,
+		"lease_time": lease.LeaseTime,
+		"rate_limit": lease.RateLimit,
+
+		"address_lists": splitDhcpLeaseList(lease.AddressLists),
+		"dhcp_options":  splitDhcpLeaseList(lease.DhcpOption),
+		"use_src_mac":   lease.UseSrcMac,
 	}
 
 	d.SetId(lease.Id)
@@ -147,12 +230,35 @@ func leaseToData(lease *client.DhcpLease, d *schema.ResourceData) diag.Diagnosti
 func prepareDhcpLease(d *schema.ResourceData) *client.DhcpLease {
 	lease := new(client.DhcpLease)
 
-	lease.BlockAccess, _ = strconv.ParseBool(d.Get("blocked").(string))
+	lease.BlockAccess = normalize.Bool(d.Get("blocked").(string)) == "true"
 	lease.Comment = d.Get("comment").(string)
 	lease.Address = d.Get("address").(string)
 	lease.MacAddress = d.Get("macaddress").(string)
-	lease.Hostname = d.Get("hostname").(string)
-	lease.Dynamic = d.Get("dynamic").(bool)
+	lease.Server = d.Get("server").(string)
+	lease.ClientId = d.Get("client_id").(string)
+	lease.LeaseTime = d.Get("lease_time").(string)
+	lease.RateLimit = d.Get("rate_limit").(string)
+	lease.AddressLists = joinDhcpLeaseList(d.Get("address_lists").(*schema.Set))
+	lease.DhcpOption = joinDhcpLeaseList(d.Get("dhcp_options").(*schema.Set))
+	lease.UseSrcMac = d.Get("use_src_mac").(bool)
 
 	return lease
 }
+
+func joinDhcpLeaseList(set *schema.Set) string {
+	names := []string{}
+	for _, name := range set.List() {
+		names = append(names, name.(string))
+	}
+	return strings.Join(names, ",")
+}
+
+func splitDhcpLeaseList(value string) []string {
+	names := []string{}
+	for _, name := range strings.Split(value, ",") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
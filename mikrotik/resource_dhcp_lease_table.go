@@ -0,0 +1,311 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+/**
+ * Define DHCP Lease Table Resource: every static Lease of a DHCP Server, written in Batches
+ */
+func resourceDhcpLeaseTable() *schema.Resource {
+
+	// Build and Return Resource
+	return &schema.Resource{
+
+		// Resource Description
+		Description: "Manages every static lease of a DHCP server within MikroTik device. Leases are added, updated and removed in pipelined batches. Use `mikrotik_dhcp_lease` instead for leases with their own settings.",
+
+		// Create Resource Context Method CallBack
+		CreateContext: createDhcpLeaseTable,
+
+		// Read Resource Context Method CallBack
+		ReadContext: readDhcpLeaseTable,
+
+		// Update Resource Context Method CallBack
+		UpdateContext: updateDhcpLeaseTable,
+
+		// Delete Resource Context Method CallBack
+		DeleteContext: deleteDhcpLeaseTable,
+
+		// Define Resource State Context Importer
+		Importer: &schema.ResourceImporter{
+
+			// Define State Context (the ID is the Server Name)
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Define Resource Schema
+		Schema: map[string]*schema.Schema{
+			"server": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the DHCP server the leases belong to, `all` for leases given by any server.",
+			},
+			"lease": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"macaddress": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateMacAddress,
+							DiffSuppressFunc: normalize.SuppressEquivalent(normalize.MacAddress),
+							Description:      "The MAC address of the lease.",
+						},
+						"address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateIpv4Address,
+							Description:      "The IP address of the lease.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The comment of the lease.",
+						},
+						"blocked": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to block access for this DHCP client.",
+						},
+					},
+				},
+				Set:         hashDhcpLeaseTableLease,
+				Description: "Static leases of the DHCP server. A MAC address can only be given once.",
+			},
+		},
+	}
+}
+
+/**
+ * Function used to Create DHCP Lease Table
+ */
+func createDhcpLeaseTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get DHCP Lease Table Client
+	c := m.(*client.Mikrotik)
+
+	// Add every Lease in one Batch
+	_, err := c.AddDhcpLeaseTable(dataToDhcpLeaseTableEntries(d))
+
+	// Set ID (the added Leases are tracked even if others failed)
+	d.SetId(d.Get("server").(string))
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Reload DHCP Lease Table
+	return readDhcpLeaseTable(ctx, d, m)
+}
+
+/**
+ * Function used to Read DHCP Lease Table
+ */
+func readDhcpLeaseTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get DHCP Lease Table Client
+	c := m.(*client.Mikrotik)
+
+	// List Leases
+	entries, err := c.ListDhcpLeaseTable(d.Id())
+
+	// If Server has no static Lease anymore
+	if err == nil && len(entries) == 0 {
+
+		// Report Leases as Not Found
+		err = client.NewNotFound(fmt.Sprintf("leases of dhcp server `%s` not found", d.Id()))
+	}
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return readError(d, err)
+	}
+
+	// Convert Leases to Resource Data and put it in Resource Pointer
+	dhcpLeaseTableToData(d.Id(), entries, d)
+
+	// Return Diagnostic
+	return nil
+}
+
+/**
+ * Function used to Update DHCP Lease Table: Leases are matched by MAC Address, missing ones are added,
+ * extra ones removed, and the kept ones updated when their Address, Comment or Blocked Flag changed
+ */
+func updateDhcpLeaseTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get DHCP Lease Table Client
+	c := m.(*client.Mikrotik)
+
+	// List current Leases
+	entries, err := c.ListDhcpLeaseTable(d.Id())
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Get wanted Leases by MAC Address
+	wanted := map[string]client.DhcpLeaseTableEntry{}
+	for _, entry := range dataToDhcpLeaseTableEntries(d) {
+		wanted[entry.MacAddress] = entry
+	}
+
+	// Sort current Leases into Leases to Remove and Leases to Update
+	removed := []string{}
+	updated := []client.DhcpLeaseTableEntry{}
+	for _, entry := range entries {
+		lease, ok := wanted[normalize.MacAddress(entry.MacAddress)]
+		switch {
+		case !ok:
+			removed = append(removed, entry.Id)
+		case entry.Address != lease.Address || entry.Comment != lease.Comment || entry.BlockAccess != lease.BlockAccess:
+			lease.Id = entry.Id
+			updated = append(updated, lease)
+		}
+		delete(wanted, normalize.MacAddress(entry.MacAddress))
+	}
+
+	// Get Leases to Add
+	added := []client.DhcpLeaseTableEntry{}
+	for _, lease := range wanted {
+		added = append(added, lease)
+	}
+	sort.Slice(added, func(i, j int) bool { return added[i].MacAddress < added[j].MacAddress })
+
+	// Remove Leases in one Batch (first, so that their Addresses can be given again)
+	if err := c.DeleteDhcpLeaseTable(removed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Update Leases in one Batch
+	if err := c.UpdateDhcpLeaseTable(updated); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Add Leases in one Batch
+	if _, err := c.AddDhcpLeaseTable(added); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Reload DHCP Lease Table
+	return readDhcpLeaseTable(ctx, d, m)
+}
+
+/**
+ * Function used to Delete DHCP Lease Table
+ */
+func deleteDhcpLeaseTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get DHCP Lease Table Client
+	c := m.(*client.Mikrotik)
+
+	// List Leases
+	entries, err := c.ListDhcpLeaseTable(d.Id())
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return deleteError(d, err)
+	}
+
+	// Collect Lease IDs
+	ids := []string{}
+	for _, entry := range entries {
+		ids = append(ids, entry.Id)
+	}
+
+	// Remove every Lease in one Batch
+	if err := c.DeleteDhcpLeaseTable(ids); err != nil {
+
+		// Return Error
+		return deleteError(d, err)
+	}
+
+	// Return Diagnostic
+	return nil
+}
+
+/**
+ * Function used to build the Leases of the Resource Data, sorted by MAC Address
+ */
+func dataToDhcpLeaseTableEntries(d *schema.ResourceData) []client.DhcpLeaseTableEntry {
+
+	// Initialize Leases
+	entries := []client.DhcpLeaseTableEntry{}
+
+	// For each Lease
+	for _, item := range d.Get("lease").(*schema.Set).List() {
+		lease := item.(map[string]interface{})
+		entries = append(entries, client.DhcpLeaseTableEntry{
+			Server:      d.Get("server").(string),
+			MacAddress:  normalize.MacAddress(lease["macaddress"].(string)),
+			Address:     lease["address"].(string),
+			Comment:     lease["comment"].(string),
+			BlockAccess: lease["blocked"].(bool),
+		})
+	}
+
+	// Sort Leases (Batches are then run in a stable Order)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].MacAddress < entries[j].MacAddress })
+
+	// Return Leases
+	return entries
+}
+
+/**
+ * Function used to put the Leases of a DHCP Server in the Resource Data
+ */
+func dhcpLeaseTableToData(server string, entries []client.DhcpLeaseTableEntry, d *schema.ResourceData) {
+
+	// Collect Leases
+	leases := []interface{}{}
+	for _, entry := range entries {
+		leases = append(leases, map[string]interface{}{
+			"macaddress": normalize.MacAddress(entry.MacAddress),
+			"address":    entry.Address,
+			"comment":    entry.Comment,
+			"blocked":    entry.BlockAccess,
+		})
+	}
+
+	// Set Values
+	d.Set("server", server)
+	d.Set("lease", schema.NewSet(hashDhcpLeaseTableLease, leases))
+}
+
+/**
+ * Function used to Hash a Lease with its MAC Address in RouterOS Form, so that `aa:bb:cc:dd:ee:ff` and
+ * `AA:BB:CC:DD:EE:FF` are the same Element
+ */
+func hashDhcpLeaseTableLease(value interface{}) int {
+
+	// Get Lease
+	lease := value.(map[string]interface{})
+
+	// Hash Normalized Lease
+	return schema.HashString(fmt.Sprintf("%s|%s|%s|%t",
+		normalize.MacAddress(lease["macaddress"].(string)), lease["address"], lease["comment"], lease["blocked"]))
+}
@@ -0,0 +1,198 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * DHCP Lease Table Resource Create, Update and Import Test
+ */
+func TestDhcpLeaseTable_CreateUpdateImport(t *testing.T) {
+
+	// Initialize Resource Name
+	resourceName := "mikrotik_dhcp_lease_table.testacc"
+
+	// Initialize Leases (enough Leases to be written in several Batch Windows)
+	leases := []string{}
+	for i := 1; i <= 40; i++ {
+		leases = append(leases, testAccDhcpLeaseTableLease(fmt.Sprintf("02:00:00:00:00:%02X", i), fmt.Sprintf("10.97.0.%d", i), false))
+	}
+
+	// Initialize Updated Leases (removes ten Leases, blocks one, moves another and adds a lowercase MAC Address)
+	updatedLeases := append([]string{
+		testAccDhcpLeaseTableLease("02:00:00:00:00:0B", "10.97.0.11", true),
+		testAccDhcpLeaseTableLease("02:00:00:00:00:0C", "10.97.1.12", false),
+		testAccDhcpLeaseTableLease("02:00:00:00:01:aa", "10.97.1.1", false),
+	}, leases[12:]...)
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Check destroy Callback
+		CheckDestroy: testAccCheckDhcpLeaseTableDestroy,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Resource
+				Config: testAccDhcpLeaseTable(leases),
+
+				// Check Test Result
+				Check: resource.ComposeTestCheckFunc(
+					testAccDhcpLeaseTableLeases(resourceName, len(leases), 0),
+					resource.TestCheckResourceAttr(resourceName, "id", "all"),
+					resource.TestCheckResourceAttr(resourceName, "lease.#", fmt.Sprint(len(leases))),
+				),
+			},
+			{
+				// Configure Updated Test Resource (removes, adds and updates Leases)
+				Config: testAccDhcpLeaseTable(updatedLeases),
+
+				// Check Test Result
+				Check: resource.ComposeTestCheckFunc(
+					testAccDhcpLeaseTableLeases(resourceName, len(updatedLeases), 1),
+					resource.TestCheckResourceAttr(resourceName, "lease.#", fmt.Sprint(len(updatedLeases))),
+				),
+			},
+			{
+				// Import Test Resource
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+/**
+ * Function used to Check the static Leases of the DHCP Server on the Router
+ */
+func testAccDhcpLeaseTableLeases(resourceName string, count int, blocked int) resource.TestCheckFunc {
+
+	// Find and return result
+	return func(s *terraform.State) error {
+
+		// Find resource
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		// If not OK
+		if !ok {
+
+			// Return Not Found Error Message
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		// Build Client
+		c := client.NewClient(client.GetConfigFromEnv())
+
+		// List Leases
+		entries, err := c.ListDhcpLeaseTable(rs.Primary.ID)
+
+		// If there are Error
+		if err != nil {
+
+			// Return Formatted Error Message
+			return fmt.Errorf("Unable to get remote leases for %s: %v", resourceName, err)
+		}
+
+		// If Lease Count does not match
+		if len(entries) != count {
+
+			// Return Formatted Message
+			return fmt.Errorf("expected %d leases in %s, got %d", count, rs.Primary.ID, len(entries))
+		}
+
+		// Count blocked Leases
+		for _, entry := range entries {
+			if entry.BlockAccess {
+				blocked--
+			}
+		}
+		if blocked != 0 {
+			return fmt.Errorf("unexpected count of blocked leases in %s", rs.Primary.ID)
+		}
+
+		// Return Null
+		return nil
+	}
+}
+
+/**
+ * Function used to Test if Terraform Resource is Destroyed
+ */
+func testAccCheckDhcpLeaseTableDestroy(s *terraform.State) error {
+
+	// Build Client
+	c := client.NewClient(client.GetConfigFromEnv())
+
+	// Iterate over Resources
+	for _, rs := range s.RootModule().Resources {
+
+		// If Resource is not DHCP Lease Table
+		if rs.Type != "mikrotik_dhcp_lease_table" {
+
+			// Continue Iteration
+			continue
+		}
+
+		// List Leases
+		entries, err := c.ListDhcpLeaseTable(rs.Primary.ID)
+
+		// If there is Error
+		if err != nil {
+
+			// Return Error
+			return err
+		}
+
+		// If Leases Exist
+		if len(entries) > 0 {
+
+			// Return Formatted Error
+			return fmt.Errorf("remote dhcp server (%s) still has %d static leases", rs.Primary.ID, len(entries))
+		}
+	}
+
+	// Return nil
+	return nil
+}
+
+/**
+ * Function used to build a Lease Block of the Terraform Resource Configuration
+ */
+func testAccDhcpLeaseTableLease(macaddress string, address string, blocked bool) string {
+
+	// Return Lease Block
+	return fmt.Sprintf(`
+	lease {
+		macaddress = %q
+		address    = %q
+		blocked    = %t
+	}`, macaddress, address, blocked)
+}
+
+/**
+ * Function used to build Terraform Resource Configuration
+ */
+func testAccDhcpLeaseTable(leases []string) string {
+
+	// Return Resource Configuration
+	return fmt.Sprintf(`
+resource "mikrotik_dhcp_lease_table" "testacc" {
+	server = "all"
+%s
+}
+`, strings.Join(leases, "\n"))
+}
@@ -11,6 +11,22 @@ import (
 	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal"
 )
 
+func TestPrepareDhcpLeaseBlocked(t *testing.T) {
+	resource := resourceLease()
+	for value, expected := range map[string]bool{"true": true, "yes": true, "false": false, "no": false} {
+		d := resource.Data(nil)
+		d.Set("blocked", value)
+		if lease := prepareDhcpLease(d); lease.BlockAccess != expected {
+			t.Errorf("blocked = %q was sent as block-access=%v", value, lease.BlockAccess)
+		}
+	}
+
+	// yes and no in the configuration match the true and false read back
+	if !resource.Schema["blocked"].DiffSuppressFunc("blocked", "true", "yes", nil) || resource.Schema["blocked"].DiffSuppressFunc("blocked", "false", "yes", nil) {
+		t.Error("expected yes to match true only")
+	}
+}
+
 func TestAccMikrotikDhcpLease_create(t *testing.T) {
 	ipAddr := internal.GetNewIpAddr()
 	macAddr := internal.GetNewMacAddr()
@@ -123,6 +139,12 @@ func TestAccMikrotikDhcpLease_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "mac=" + macAddr,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -149,6 +171,40 @@ func TestAccMikrotikDhcpLease_createDynamicDiff(t *testing.T) {
 	})
 }
 
+func TestAccMikrotikDhcpLease_settings(t *testing.T) {
+	ipAddr := internal.GetNewIpAddr()
+	macAddr := internal.GetNewMacAddr()
+
+	resourceName := "mikrotik_dhcp_lease.bar"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikDhcpLeaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpLeaseSettings(ipAddr, macAddr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDhcpLeaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server", "all"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit", "10M/10M"),
+					resource.TestCheckResourceAttr(resourceName, "address_lists.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "use_src_mac", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dynamic", "false"),
+				),
+			},
+			{
+				Config: testAccDhcpLease(ipAddr, macAddr, "settings cleared"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDhcpLeaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit", ""),
+					resource.TestCheckResourceAttr(resourceName, "address_lists.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "use_src_mac", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDhcpLease(ipAddr, macAddr, comment string) string {
 	return fmt.Sprintf(`
 resource "mikrotik_dhcp_lease" "bar" {
@@ -170,6 +226,20 @@ resource "mikrotik_dhcp_lease" "bar" {
 `, ipAddr, macAddr, comment)
 }
 
+func testAccDhcpLeaseSettings(ipAddr, macAddr string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_dhcp_lease" "bar" {
+    address       = "%s"
+    macaddress    = "%s"
+    lease_time    = "1h"
+    rate_limit    = "10M/10M"
+    address_lists = ["tf-acc-leases"]
+    use_src_mac   = true
+    adopt_dynamic = true
+}
+`, ipAddr, macAddr)
+}
+
 func testAccDhcpLeaseUpdatedBlockAccess(ipAddr, macAddr, comment string, blocked bool) string {
 	return fmt.Sprintf(`
 resource "mikrotik_dhcp_lease" "bar" {
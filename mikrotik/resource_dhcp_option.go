@@ -0,0 +1,259 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+// dhcpOptionValues lists the attributes a DHCP option value is given with, exactly one is set
+var dhcpOptionValues = []string{"value", "string_value", "ip_values", "hex_value"}
+
+func resourceDhcpOption() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a DHCP option, handed out through option sets or DHCP networks.",
+
+		CreateContext: resourceDhcpOptionCreate,
+		ReadContext:   resourceDhcpOptionRead,
+		UpdateContext: resourceDhcpOptionUpdate,
+		DeleteContext: resourceDhcpOptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ip/dhcp-server/option", "name"),
+		},
+
+		CustomizeDiff: resourceDhcpOptionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the option, referenced by option sets.",
+			},
+			"code": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 254)),
+				Description:      "DHCP option code (e.g. 66 for the TFTP server, 67 for the boot file, 43 for vendor specific information, 121 for classless routes).",
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: dhcpOptionValues,
+				Description:  "Value as a RouterOS option expression (e.g. `'10.0.0.1'`, `s'pxelinux.0'`, `0x0a0b` or `$(NETWORK_GATEWAY)`). Computed from the typed value attributes when one of them is used.",
+			},
+			"string_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateString(checkDhcpOptionString),
+				Description:      "Value as text, sent as is (encoded as `s'<text>'`).",
+			},
+			"ip_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateIpv4Address,
+				},
+				Description: "Value as IPv4 addresses, 4 bytes each (encoded as `'<ip>'` per address).",
+			},
+			"hex_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateString(checkHex),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalizeHex),
+				Description:      "Value as bytes in hexadecimal, e.g. `0x0a0b` or `0a:0b` (encoded as `0x<hex>`).",
+			},
+			"raw_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Bytes the value encodes to, in hexadecimal.",
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceDhcpOptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddDhcpOption(dataToDhcpOption(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceDhcpOptionRead(ctx, d, m)
+}
+
+func resourceDhcpOptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindDhcpOption(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return dhcpOptionToData(record, d)
+}
+
+func resourceDhcpOptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateDhcpOption(dataToDhcpOption(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDhcpOptionRead(ctx, d, m)
+}
+
+func resourceDhcpOptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteDhcpOption(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+// resourceDhcpOptionCustomizeDiff plans the option expression a typed value encodes to
+func resourceDhcpOptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, key := range dhcpOptionValues[1:] {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("value")
+		}
+	}
+
+	if value, ok := typedDhcpOptionValue(d.Get); ok && value != d.Get("value").(string) {
+		return d.SetNew("value", value)
+	}
+
+	return nil
+}
+
+func dataToDhcpOption(d *schema.ResourceData) *client.DhcpOption {
+	r := &client.DhcpOption{}
+	r.Id = d.Id()
+	r.Name = d.Get("name").(string)
+	r.Code = d.Get("code").(int)
+	r.Comment = d.Get("comment").(string)
+
+	r.Value = d.Get("value").(string)
+	if value, ok := typedDhcpOptionValue(d.Get); ok {
+		r.Value = value
+	}
+
+	return r
+}
+
+func dhcpOptionToData(r *client.DhcpOption, d *schema.ResourceData) diag.Diagnostics {
+	values := map[string]interface{}{
+		"name":      r.Name,
+		"code":      r.Code,
+		"value":     r.Value,
+		"raw_value": r.RawValue,
+		"comment":   r.Comment,
+	}
+
+	// Typed values are read back only when in use, holding their zero value when the expression is of another form
+	if _, ok := d.GetOk("string_value"); ok {
+		values["string_value"], _ = decodeDhcpOptionString(r.Value)
+	}
+	if _, ok := d.GetOk("ip_values"); ok {
+		values["ip_values"], _ = decodeDhcpOptionIps(r.Value)
+	}
+	if _, ok := d.GetOk("hex_value"); ok {
+		values["hex_value"], _ = decodeDhcpOptionHex(r.Value)
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
+
+// typedDhcpOptionValue encodes the typed value set, if any, to a RouterOS option expression
+func typedDhcpOptionValue(get func(key string) interface{}) (string, bool) {
+	if text := get("string_value").(string); text != "" {
+		return encodeDhcpOptionString(text), true
+	}
+
+	if ips := get("ip_values").([]interface{}); len(ips) > 0 {
+		addresses := []string{}
+		for _, ip := range ips {
+			addresses = append(addresses, ip.(string))
+		}
+		return encodeDhcpOptionIps(addresses), true
+	}
+
+	if hex := get("hex_value").(string); hex != "" {
+		return encodeDhcpOptionHex(hex), true
+	}
+
+	return "", false
+}
+
+// dhcpOptionIpPattern matches an option expression made of quoted IPv4 addresses, one after the other
+var dhcpOptionIpPattern = regexp.MustCompile(`^('\d{1,3}(\.\d{1,3}){3}')+$`)
+
+func checkDhcpOptionString(value string) error {
+	if strings.Contains(value, "'") {
+		return fmt.Errorf("RouterOS option strings cannot hold single quotes")
+	}
+	return nil
+}
+
+func encodeDhcpOptionString(text string) string {
+	return "s'" + text + "'"
+}
+
+func decodeDhcpOptionString(value string) (string, bool) {
+	if !strings.HasPrefix(value, "s'") || !strings.HasSuffix(value, "'") || len(value) < 3 {
+		return "", false
+	}
+	return value[2 : len(value)-1], true
+}
+
+func encodeDhcpOptionIps(ips []string) string {
+	return "'" + strings.Join(ips, "''") + "'"
+}
+
+func decodeDhcpOptionIps(value string) ([]string, bool) {
+	if !dhcpOptionIpPattern.MatchString(value) {
+		return []string{}, false
+	}
+	return strings.Split(strings.Trim(value, "'"), "''"), true
+}
+
+func encodeDhcpOptionHex(hex string) string {
+	return "0x" + normalizeHex(hex)
+}
+
+func decodeDhcpOptionHex(value string) (string, bool) {
+	if !strings.HasPrefix(value, "0x") || checkHex(value) != nil {
+		return "", false
+	}
+	return normalizeHex(value), true
+}
+
+// normalizeHex lower cases hexadecimal bytes, without 0x prefix nor separators
+func normalizeHex(value string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(value, "0x"), ":", ""))
+}
@@ -0,0 +1,128 @@
+package mikrotik
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func resourceDhcpOptionSet() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a DHCP option set, the options handed out by the DHCP servers or networks referencing it.",
+
+		CreateContext: resourceDhcpOptionSetCreate,
+		ReadContext:   resourceDhcpOptionSetRead,
+		UpdateContext: resourceDhcpOptionSetUpdate,
+		DeleteContext: resourceDhcpOptionSetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ip/dhcp-server/option/sets", "name"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the option set, referenced by `dhcp_option_set` of DHCP servers and networks.",
+			},
+			"options": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateName,
+				},
+				Description: "Names of the DHCP options in the set.",
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceDhcpOptionSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddDhcpOptionSet(dataToDhcpOptionSet(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceDhcpOptionSetRead(ctx, d, m)
+}
+
+func resourceDhcpOptionSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindDhcpOptionSet(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return dhcpOptionSetToData(record, d)
+}
+
+func resourceDhcpOptionSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateDhcpOptionSet(dataToDhcpOptionSet(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDhcpOptionSetRead(ctx, d, m)
+}
+
+func resourceDhcpOptionSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteDhcpOptionSet(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+func dataToDhcpOptionSet(d *schema.ResourceData) *client.DhcpOptionSet {
+	options := []string{}
+	for _, option := range d.Get("options").(*schema.Set).List() {
+		options = append(options, option.(string))
+	}
+
+	return &client.DhcpOptionSet{
+		Id:      d.Id(),
+		Name:    d.Get("name").(string),
+		Options: strings.Join(options, ","),
+		Comment: d.Get("comment").(string),
+	}
+}
+
+func dhcpOptionSetToData(r *client.DhcpOptionSet, d *schema.ResourceData) diag.Diagnostics {
+	options := []string{}
+	for _, option := range strings.Split(r.Options, ",") {
+		if option != "" {
+			options = append(options, option)
+		}
+	}
+
+	values := map[string]interface{}{
+		"name":    r.Name,
+		"options": options,
+		"comment": r.Comment,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
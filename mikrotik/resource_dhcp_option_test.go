@@ -0,0 +1,111 @@
+package mikrotik
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikDhcpOption_createAndSet(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-dhcp-option")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikDhcpOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpOption(name, `string_value = "pxelinux.0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcp_option.boot_file", "value", "s'pxelinux.0'"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_option.boot_file", "raw_value", "7078656c696e75782e30"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_option_set.pxe", "options.#", "2"),
+				),
+			},
+			{
+				Config: testAccDhcpOption(name, `hex_value = "70:78:65"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcp_option.boot_file", "value", "0x707865"),
+				),
+			},
+			{
+				ResourceName:            "mikrotik_dhcp_option.boot_file",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hex_value"},
+			},
+			{
+				ResourceName:      "mikrotik_dhcp_option_set.pxe",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDhcpOptionValueEncoding(t *testing.T) {
+	if value := encodeDhcpOptionIps([]string{"10.0.0.1", "10.0.0.2"}); value != "'10.0.0.1''10.0.0.2'" {
+		t.Errorf("unexpected ip encoding: %s", value)
+	}
+	if ips, ok := decodeDhcpOptionIps("'10.0.0.1''10.0.0.2'"); !ok || !reflect.DeepEqual(ips, []string{"10.0.0.1", "10.0.0.2"}) {
+		t.Errorf("unexpected ip decoding: %v", ips)
+	}
+	if _, ok := decodeDhcpOptionIps("s'10.0.0.1'"); ok {
+		t.Errorf("expected a string not to decode as ip addresses")
+	}
+	if text, ok := decodeDhcpOptionString(encodeDhcpOptionString("10.0.0.1")); !ok || text != "10.0.0.1" {
+		t.Errorf("unexpected string round trip: %s", text)
+	}
+	if hex, ok := decodeDhcpOptionHex(encodeDhcpOptionHex("0A:0B")); !ok || hex != "0a0b" {
+		t.Errorf("unexpected hex round trip: %s", hex)
+	}
+	if _, ok := decodeDhcpOptionHex("$(NETWORK_GATEWAY)"); ok {
+		t.Errorf("expected a variable not to decode as hex")
+	}
+}
+
+func testAccDhcpOption(name, value string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_dhcp_option" "tftp_server" {
+  name      = "%[1]s-tftp"
+  code      = 66
+  ip_values = ["10.0.0.1"]
+}
+
+resource "mikrotik_dhcp_option" "boot_file" {
+  name = "%[1]s-boot"
+  code = 67
+  %[2]s
+}
+
+resource "mikrotik_dhcp_option_set" "pxe" {
+  name    = "%[1]s"
+  options = [mikrotik_dhcp_option.tftp_server.name, mikrotik_dhcp_option.boot_file.name]
+}
+`, name, value)
+}
+
+func testAccCheckMikrotikDhcpOptionDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		var err error
+		switch rs.Type {
+		case "mikrotik_dhcp_option":
+			_, err = c.FindDhcpOption(rs.Primary.ID)
+		case "mikrotik_dhcp_option_set":
+			_, err = c.FindDhcpOptionSet(rs.Primary.ID)
+		default:
+			continue
+		}
+
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package mikrotik
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+func resourceDhcpRelay() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a DHCP relay, forwarding the DHCP requests received on an interface to the DHCP servers of another network.",
+
+		CreateContext: resourceDhcpRelayCreate,
+		ReadContext:   resourceDhcpRelayRead,
+		UpdateContext: resourceDhcpRelayUpdate,
+		DeleteContext: resourceDhcpRelayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ip/dhcp-relay", "name"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the relay.",
+			},
+			"interface": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface the DHCP requests are received on.",
+			},
+			"dhcp_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateIpv4Address,
+				},
+				Description: "Addresses of the DHCP servers the requests are forwarded to.",
+			},
+			"local_address": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0.0.0.0",
+				ValidateDiagFunc: validateIpv4Address,
+				Description:      "Address the requests are forwarded from, and the servers identify the network with. An address of `interface` when `0.0.0.0`.",
+			},
+			"delay_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "none",
+				ValidateDiagFunc: validateDuration("none"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Requests waiting longer than this (`secs` field) are ignored, `none` to forward them all.",
+			},
+			"add_relay_info": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the relay agent information (option 82) is added to the forwarded requests.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the relay is disabled.",
+			},
+		},
+	}
+}
+
+func resourceDhcpRelayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddDhcpRelay(dataToDhcpRelay(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceDhcpRelayRead(ctx, d, m)
+}
+
+func resourceDhcpRelayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindDhcpRelay(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return dhcpRelayToData(record, d)
+}
+
+func resourceDhcpRelayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateDhcpRelay(dataToDhcpRelay(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDhcpRelayRead(ctx, d, m)
+}
+
+func resourceDhcpRelayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteDhcpRelay(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+func dataToDhcpRelay(d *schema.ResourceData) *client.DhcpRelay {
+	servers := []string{}
+	for _, server := range d.Get("dhcp_servers").([]interface{}) {
+		servers = append(servers, server.(string))
+	}
+
+	return &client.DhcpRelay{
+		Id:             d.Id(),
+		Name:           d.Get("name").(string),
+		Interface:      d.Get("interface").(string),
+		DhcpServer:     strings.Join(servers, ","),
+		LocalAddress:   d.Get("local_address").(string),
+		DelayThreshold: d.Get("delay_threshold").(string),
+		AddRelayInfo:   d.Get("add_relay_info").(bool),
+		Disabled:       d.Get("disabled").(bool),
+	}
+}
+
+func dhcpRelayToData(r *client.DhcpRelay, d *schema.ResourceData) diag.Diagnostics {
+	servers := []string{}
+	for _, server := range strings.Split(r.DhcpServer, ",") {
+		if server != "" {
+			servers = append(servers, server)
+		}
+	}
+
+	values := map[string]interface{}{
+		"name":            r.Name,
+		"interface":       r.Interface,
+		"dhcp_servers":    servers,
+		"local_address":   r.LocalAddress,
+		"delay_threshold": r.DelayThreshold,
+		"add_relay_info":  r.AddRelayInfo,
+		"disabled":        r.Disabled,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,73 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikDhcpRelay_createAndUpdate(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-dhcp-relay")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikDhcpRelayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpRelay(name, `["10.0.0.1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcp_relay.branch", "dhcp_servers.#", "1"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_relay.branch", "local_address", "0.0.0.0"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_relay.branch", "delay_threshold", "none"),
+				),
+			},
+			{
+				Config: testAccDhcpRelay(name, `["10.0.0.1", "10.0.0.2"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcp_relay.branch", "dhcp_servers.#", "2"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_relay.branch", "dhcp_servers.1", "10.0.0.2"),
+				),
+			},
+			{
+				ResourceName:      "mikrotik_dhcp_relay.branch",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDhcpRelay(name, servers string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "branch" {
+  name = %[1]q
+}
+
+resource "mikrotik_dhcp_relay" "branch" {
+  name         = %[1]q
+  interface    = mikrotik_bridge_interface.branch.name
+  dhcp_servers = %[2]s
+  disabled     = true
+}
+`, name, servers)
+}
+
+func testAccCheckMikrotikDhcpRelayDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_dhcp_relay" {
+			continue
+		}
+
+		_, err := c.FindDhcpRelay(rs.Primary.ID)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
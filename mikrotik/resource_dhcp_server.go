@@ -2,14 +2,16 @@ package mikrotik
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceDhcpServer() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Manages a DHCP server resource within MikroTik device.",
 
 		CreateContext: createDhcpServer,
@@ -18,9 +20,11 @@ func resourceDhcpServer() *schema.Resource {
 		DeleteContext: deleteDhcpServer,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/ip/dhcp-server", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -32,16 +36,18 @@ func resourceDhcpServer() *schema.Resource {
 				Description: "Whether to add dynamic ARP entry. If set to no either ARP mode should be enabled on that interface or static ARP entries should be administratively defined.",
 			},
 			"address_pool": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "static-only",
-				Description: "IP pool, from which to take IP addresses for the clients. If set to static-only, then only the clients that have a static lease (added in lease submenu) will be allowed.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "static-only",
+				ValidateDiagFunc: validateName,
+				Description:      "IP pool, from which to take IP addresses for the clients. If set to `static-only`, then only the clients that have a static lease (added in lease submenu) will be allowed.",
 			},
 			"authoritative": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "yes",
-				Description: "Option changes the way how server responds to DHCP requests.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "yes",
+				ValidateDiagFunc: validateEnum("after-10sec-delay", "after-2sec-delay", "yes", "no"),
+				Description:      "Option changes the way how server responds to DHCP requests.",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -50,24 +56,90 @@ func resourceDhcpServer() *schema.Resource {
 				Description: "Disable this DHCP server instance.",
 			},
 			"interface": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "*0",
-				Description: "Interface on which server will be running.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "*0",
+				ValidateDiagFunc: validateName,
+				Description:      "Interface on which server will be running.",
 			},
 			"lease_script": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Script that will be executed after lease is assigned or de-assigned. Internal \"global\" variables that can be used in the script.",
 			},
+			"lease_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateDuration(),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Time a lease is given for (e.g. `30m`, `1d`). The router default when unset.",
+			},
+			"relay": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateIpv4Address,
+				Description:      "IP address of the DHCP relay the server answers requests from, `0.0.0.0` for requests of directly attached clients.",
+			},
+			"conflict_detection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the server checks with ARP and ICMP that an address is free before leasing it.",
+			},
+			"use_radius": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "no",
+				ValidateDiagFunc: validateEnum("yes", "no", "accounting"),
+				Description:      "Whether leases are authorized by RADIUS (`yes`), only accounted (`accounting`, RouterOS v7) or not (`no`).",
+			},
+			"always_broadcast": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether replies are always broadcast, even to clients able to receive unicast.",
+			},
+			"bootp_support": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "static",
+				ValidateDiagFunc: validateEnum("none", "static", "dynamic"),
+				Description:      "Which BOOTP clients are answered: `none`, `static` (static leases only) or `dynamic`.",
+			},
+			"address_lists": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateName,
+				},
+				Description: "Firewall address lists the leased addresses are added to.",
+			},
+			"delay_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "none",
+				ValidateDiagFunc: validateDuration("none"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Requests waiting longer than this in the queue (`secs` field) are ignored, `none` to answer them all.",
+			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Reference name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Reference name.",
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceDhcpServerV0(), "/ip/dhcp-server", "name"),
+	}
+
+	return resource
 }
 
 func createDhcpServer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -78,7 +150,7 @@ func createDhcpServer(ctx context.Context, d *schema.ResourceData, m interface{}
 	}
 
 	dhcpServerToData(dhcpServer, d)
-	d.SetId(dhcpServer.Name)
+	d.SetId(dhcpServer.Id)
 
 	return readDhcpServer(ctx, d, m)
 }
@@ -88,7 +160,7 @@ func readDhcpServer(ctx context.Context, d *schema.ResourceData, m interface{})
 	c := m.(*client.Mikrotik)
 	dhcpServer, err := c.FindDhcpServer(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	dhcpServerToData(dhcpServer, d)
@@ -113,13 +185,18 @@ func deleteDhcpServer(ctx context.Context, d *schema.ResourceData, m interface{}
 	c := m.(*client.Mikrotik)
 	err := c.DeleteDhcpServer(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	return diags
 }
 
 func dataToDhcpServer(d *schema.ResourceData) *client.DhcpServer {
+	addressLists := []string{}
+	for _, list := range d.Get("address_lists").(*schema.Set).List() {
+		addressLists = append(addressLists, list.(string))
+	}
+
 	return &client.DhcpServer{
 		Id:            d.Id(),
 		AddArp:        d.Get("add_arp").(bool),
@@ -129,6 +206,15 @@ func dataToDhcpServer(d *schema.ResourceData) *client.DhcpServer {
 		Interface:     d.Get("interface").(string),
 		LeaseScript:   d.Get("lease_script").(string),
 		Name:          d.Get("name").(string),
+
+		LeaseTime:         d.Get("lease_time").(string),
+		Relay:             d.Get("relay").(string),
+		ConflictDetection: d.Get("conflict_detection").(bool),
+		UseRadius:         d.Get("use_radius").(string),
+		AlwaysBroadcast:   d.Get("always_broadcast").(bool),
+		BootpSupport:      d.Get("bootp_support").(string),
+		AddressLists:      strings.Join(addressLists, ","),
+		DelayThreshold:    d.Get("delay_threshold").(string),
 	}
 }
 
@@ -140,4 +226,19 @@ func dhcpServerToData(dhcpServer *client.DhcpServer, d *schema.ResourceData) {
 	d.Set("interface", dhcpServer.Interface)
 	d.Set("lease_script", dhcpServer.LeaseScript)
 	d.Set("name", dhcpServer.Name)
+	d.Set("lease_time", dhcpServer.LeaseTime)
+	d.Set("relay", dhcpServer.Relay)
+	d.Set("conflict_detection", dhcpServer.ConflictDetection)
+	d.Set("use_radius", dhcpServer.UseRadius)
+	d.Set("always_broadcast", dhcpServer.AlwaysBroadcast)
+	d.Set("bootp_support", dhcpServer.BootpSupport)
+	d.Set("delay_threshold", dhcpServer.DelayThreshold)
+
+	addressLists := []string{}
+	for _, list := range strings.Split(dhcpServer.AddressLists, ",") {
+		if list != "" {
+			addressLists = append(addressLists, list)
+		}
+	}
+	d.Set("address_lists", addressLists)
 }
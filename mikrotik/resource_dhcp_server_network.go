@@ -16,8 +16,11 @@ func resourceDhcpServerNetwork() *schema.Resource {
 		ReadContext:   resourceDhcpServerNetworkRead,
 		UpdateContext: resourceDhcpServerNetworkUpdate,
 		DeleteContext: resourceDhcpServerNetworkDelete,
+		CustomizeDiff: addressPlanCustomizeDiff(checkDhcpServerNetworkPlan, "address", "gateway", "netmask"),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaults(importStateByNaturalKey("/ip/dhcp-server/network", ".id"), map[string]interface{}{
+				"conflict_check": conflictCheckOff,
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,48 +30,56 @@ func resourceDhcpServerNetwork() *schema.Resource {
 				Description: "Identifier of this network.",
 			},
 			"address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The network DHCP server(s) will lease addresses from.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateString(checkIpPrefix(ipv4Family, false)),
+				Description:      "The network DHCP server(s) will lease addresses from.",
 			},
 			"comment": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"conflict_check": conflictCheckSchema(),
 			"dns_server": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The DHCP client will use these as the default DNS servers.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateIpv4AddressList,
+				Description:      "The DHCP client will use these as the default DNS servers.",
 			},
 			"gateway": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "0.0.0.0",
-				Description: "The default gateway to be used by DHCP Client.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0.0.0.0",
+				ValidateDiagFunc: validateIpv4AddressList,
+				Description:      "The default gateway to be used by DHCP Client.",
 			},
 			"netmask": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "0",
-				Description: "The actual network mask to be used by DHCP client. If set to '0' - netmask from network address will be used.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0",
+				ValidateDiagFunc: validateNumberOrKeyword(0, 32),
+				Description:      "The actual network mask to be used by DHCP client. If set to '0' - netmask from network address will be used.",
 			},
 			"next_server": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "The actual TFTP Server IP used by PXE Agent to continue Boot Process.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateIpv4AddressList,
+				Description:      "The actual TFTP Server IP used by PXE Agent to continue Boot Process.",
 			},
 			"ntp_server": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "The actual NTP Servers IP Addresses (as Comma Separated).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateIpv4AddressList,
+				Description:      "The actual NTP Servers IP Addresses (as Comma Separated).",
 			},
 			"wins_server": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "The actual WINS Servers IP Addresses (as Coma Separated).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateIpv4AddressList,
+				Description:      "The actual WINS Servers IP Addresses (as Coma Separated).",
 			},
 			"boot_file_name": {
 				Type:        schema.TypeString,
@@ -95,24 +106,24 @@ func resourceDhcpServerNetwork() *schema.Resource {
 func resourceDhcpServerNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 	r := dataToDhcpServerNetwork(d)
+	diags := addressPlanApplyCheck(c, d, checkDhcpServerNetworkPlan)
+	if diags.HasError() {
+		return diags
+	}
 	record, err := c.AddDhcpServerNetwork(r)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(record.Id)
 
-	return resourceDhcpServerNetworkRead(ctx, d, m)
+	return append(diags, resourceDhcpServerNetworkRead(ctx, d, m)...)
 }
 
 func resourceDhcpServerNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 	record, err := c.FindDhcpServerNetwork(d.Id())
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return dhcpServerNetworkToData(record, d)
@@ -121,18 +132,22 @@ func resourceDhcpServerNetworkRead(ctx context.Context, d *schema.ResourceData,
 func resourceDhcpServerNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 	r := dataToDhcpServerNetwork(d)
+	diags := addressPlanApplyCheck(c, d, checkDhcpServerNetworkPlan)
+	if diags.HasError() {
+		return diags
+	}
 	_, err := c.UpdateDhcpServerNetwork(r)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return resourceDhcpServerNetworkRead(ctx, d, m)
+	return append(diags, resourceDhcpServerNetworkRead(ctx, d, m)...)
 }
 
 func resourceDhcpServerNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 	if err := c.DeleteDhcpServerNetwork(d.Id()); err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	return nil
@@ -40,6 +40,61 @@ func TestAccDhcpServer_basic(t *testing.T) {
 	})
 }
 
+func TestAccDhcpServer_settings(t *testing.T) {
+	dhcpServer := client.DhcpServer{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDhcpServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpServerConfig("dhcp-server-settings", true, ":put 123"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDhcpServerResourceExists("mikrotik_dhcp_server.testacc", &dhcpServer),
+					resource.TestCheckResourceAttrSet("mikrotik_dhcp_server.testacc", "lease_time"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_server.testacc", "relay", "0.0.0.0"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_server.testacc", "delay_threshold", "none"),
+				),
+			},
+			{
+				Config: testAccDhcpServerSettingsConfig("dhcp-server-settings"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDhcpServerResourceExists("mikrotik_dhcp_server.testacc", &dhcpServer),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_server.testacc", "relay", "10.0.0.1"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_server.testacc", "always_broadcast", "true"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_server.testacc", "bootp_support", "none"),
+					resource.TestCheckResourceAttr("mikrotik_dhcp_server.testacc", "address_lists.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "mikrotik_dhcp_server.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDhcpServer_disappears(t *testing.T) {
+	dhcpServer := client.DhcpServer{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDhcpServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpServerConfig("dhcp-server-disappears", true, ":put 123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDhcpServerResourceExists("mikrotik_dhcp_server.testacc", &dhcpServer),
+					testAccCheckResourceDisappears(testAccProvider, resourceDhcpServer(), "mikrotik_dhcp_server.testacc"),
+					testAccCheckResourceDisappears(testAccProvider, resourceDhcpServer(), "mikrotik_dhcp_server.testacc"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccDhcpServerResourceExists(resource string, record *client.DhcpServer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -91,3 +146,18 @@ func testAccDhcpServerConfig(name string, disabled bool, leaseScript string) str
 		}
 	`, name, disabled, leaseScript)
 }
+
+func testAccDhcpServerSettingsConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "mikrotik_dhcp_server" "testacc" {
+			name               = %q
+			lease_time         = "1d"
+			relay              = "10.0.0.1"
+			conflict_detection = false
+			always_broadcast   = true
+			bootp_support      = "none"
+			address_lists      = ["dhcp-clients"]
+			delay_threshold    = "5s"
+		}
+	`, name)
+}
@@ -0,0 +1,214 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+func resourceDhcpv6Client() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a DHCPv6 client, requesting an address and/or a prefix delegated by the ISP into an IPv6 pool.",
+
+		CreateContext: resourceDhcpv6ClientCreate,
+		ReadContext:   resourceDhcpv6ClientRead,
+		UpdateContext: resourceDhcpv6ClientUpdate,
+		DeleteContext: resourceDhcpv6ClientDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ipv6/dhcp-client", "interface"),
+		},
+
+		CustomizeDiff: resourceDhcpv6ClientCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"interface": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface the requests are sent on.",
+			},
+			"request": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateEnum("address", "prefix"),
+				},
+				Description: "What is requested: an `address` for the interface, a delegated `prefix`, or both.",
+			},
+			"pool_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOptionalName,
+				Description:      "Name of the IPv6 pool the delegated prefix is added to, required when requesting a `prefix`.",
+			},
+			"pool_prefix_length": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          64,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 128)),
+				Description:      "Length of the prefixes the pool hands out from the delegated prefix.",
+			},
+			"prefix_hint": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateIpv6Prefix,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "Prefix (or only its length, e.g. `::/56`) requested from the server.",
+			},
+			"add_default_route": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether a default route through the server is added.",
+			},
+			"use_peer_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the DNS servers received are used by the router.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the client is disabled.",
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Prefix delegated by the server (e.g. `2001:db8:1200::/56`). Empty until a prefix is received.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the client at the last refresh (e.g. `searching`, `bound`).",
+			},
+		},
+	}
+}
+
+func resourceDhcpv6ClientCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddDhcpv6Client(dataToDhcpv6Client(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceDhcpv6ClientRead(ctx, d, m)
+}
+
+func resourceDhcpv6ClientRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindDhcpv6Client(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return dhcpv6ClientToData(record, d)
+}
+
+func resourceDhcpv6ClientUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateDhcpv6Client(dataToDhcpv6Client(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDhcpv6ClientRead(ctx, d, m)
+}
+
+func resourceDhcpv6ClientDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteDhcpv6Client(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+// resourceDhcpv6ClientCustomizeDiff requires a pool for delegated prefixes
+func resourceDhcpv6ClientCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("request") || !d.NewValueKnown("pool_name") {
+		return nil
+	}
+
+	if d.Get("request").(*schema.Set).Contains("prefix") && d.Get("pool_name").(string) == "" {
+		return fmt.Errorf("pool_name is required to request a prefix")
+	}
+
+	return nil
+}
+
+func dataToDhcpv6Client(d *schema.ResourceData) *client.Dhcpv6Client {
+	requests := []string{}
+	for _, request := range d.Get("request").(*schema.Set).List() {
+		requests = append(requests, request.(string))
+	}
+
+	return &client.Dhcpv6Client{
+		Id:               d.Id(),
+		Interface:        d.Get("interface").(string),
+		Request:          strings.Join(requests, ","),
+		PoolName:         d.Get("pool_name").(string),
+		PoolPrefixLength: d.Get("pool_prefix_length").(int),
+		PrefixHint:       d.Get("prefix_hint").(string),
+		AddDefaultRoute:  d.Get("add_default_route").(bool),
+		UsePeerDns:       d.Get("use_peer_dns").(bool),
+		Disabled:         d.Get("disabled").(bool),
+		Comment:          d.Get("comment").(string),
+	}
+}
+
+func dhcpv6ClientToData(r *client.Dhcpv6Client, d *schema.ResourceData) diag.Diagnostics {
+	requests := []string{}
+	for _, request := range strings.Split(r.Request, ",") {
+		if request != "" {
+			requests = append(requests, request)
+		}
+	}
+
+	values := map[string]interface{}{
+		"interface":          r.Interface,
+		"request":            requests,
+		"pool_name":          r.PoolName,
+		"pool_prefix_length": r.PoolPrefixLength,
+		"prefix_hint":        r.PrefixHint,
+		"add_default_route":  r.AddDefaultRoute,
+		"use_peer_dns":       r.UsePeerDns,
+		"disabled":           r.Disabled,
+		"comment":            r.Comment,
+		"prefix":             delegatedPrefix(r.Prefix),
+		"status":             r.Status,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
+
+// delegatedPrefix strips the expiry RouterOS prints after a delegated prefix (e.g. `2001:db8::/56, 2d23h59m`)
+func delegatedPrefix(value string) string {
+	return strings.TrimSpace(strings.SplitN(value, ",", 2)[0])
+}
@@ -0,0 +1,92 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikDhcpv6Client_createAndUpdate(t *testing.T) {
+	if client.IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	name := acctest.RandomWithPrefix("tf-acc-dhcpv6-client")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikDhcpv6ClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpv6Client(name, 64),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcpv6_client.wan", "interface", name),
+					resource.TestCheckResourceAttr("mikrotik_dhcpv6_client.wan", "request.#", "1"),
+					resource.TestCheckResourceAttr("mikrotik_dhcpv6_client.wan", "prefix", ""),
+				),
+			},
+			{
+				Config: testAccDhcpv6Client(name, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcpv6_client.wan", "pool_prefix_length", "60"),
+				),
+			},
+			{
+				ResourceName:            "mikrotik_dhcpv6_client.wan",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+	})
+}
+
+func TestDelegatedPrefix(t *testing.T) {
+	cases := map[string]string{
+		"":                               "",
+		"2001:db8:1200::/56":             "2001:db8:1200::/56",
+		"2001:db8:1200::/56, 2d23h59m5s": "2001:db8:1200::/56",
+	}
+
+	for value, expected := range cases {
+		if actual := delegatedPrefix(value); actual != expected {
+			t.Errorf("unexpected prefix of %q: %q, expected %q", value, actual, expected)
+		}
+	}
+}
+
+func testAccDhcpv6Client(name string, poolPrefixLength int) string {
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "wan" {
+  name = %[1]q
+}
+
+resource "mikrotik_dhcpv6_client" "wan" {
+  interface          = mikrotik_bridge_interface.wan.name
+  request            = ["prefix"]
+  pool_name          = %[1]q
+  pool_prefix_length = %[2]d
+  disabled           = true
+}
+`, name, poolPrefixLength)
+}
+
+func testAccCheckMikrotikDhcpv6ClientDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_dhcpv6_client" {
+			continue
+		}
+
+		_, err := c.FindDhcpv6Client(rs.Primary.ID)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
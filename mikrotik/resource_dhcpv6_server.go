@@ -0,0 +1,174 @@
+package mikrotik
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+func resourceDhcpv6Server() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a DHCPv6 server, delegating prefixes of an IPv6 pool to the routers of an interface.",
+
+		CreateContext: resourceDhcpv6ServerCreate,
+		ReadContext:   resourceDhcpv6ServerRead,
+		UpdateContext: resourceDhcpv6ServerUpdate,
+		DeleteContext: resourceDhcpv6ServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ipv6/dhcp-server", "name"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the server.",
+			},
+			"interface": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface the server answers on.",
+			},
+			"address_pool": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the IPv6 pool the delegated prefixes are taken from.",
+			},
+			"lease_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "3d",
+				ValidateDiagFunc: validateDuration(),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Time a prefix is delegated for.",
+			},
+			"preference": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          255,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 255)),
+				Description:      "Preference advertised, clients picking the server with the highest one.",
+			},
+			"rapid_commit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether prefixes are delegated in two messages instead of four, for clients asking for it.",
+			},
+			"dhcp_options": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateName,
+				},
+				Description: "Names of the DHCPv6 options handed out.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the server is disabled.",
+			},
+		},
+	}
+}
+
+func resourceDhcpv6ServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddDhcpv6Server(dataToDhcpv6Server(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceDhcpv6ServerRead(ctx, d, m)
+}
+
+func resourceDhcpv6ServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindDhcpv6Server(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return dhcpv6ServerToData(record, d)
+}
+
+func resourceDhcpv6ServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateDhcpv6Server(dataToDhcpv6Server(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDhcpv6ServerRead(ctx, d, m)
+}
+
+func resourceDhcpv6ServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteDhcpv6Server(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+func dataToDhcpv6Server(d *schema.ResourceData) *client.Dhcpv6Server {
+	options := []string{}
+	for _, option := range d.Get("dhcp_options").(*schema.Set).List() {
+		options = append(options, option.(string))
+	}
+
+	return &client.Dhcpv6Server{
+		Id:          d.Id(),
+		Name:        d.Get("name").(string),
+		Interface:   d.Get("interface").(string),
+		AddressPool: d.Get("address_pool").(string),
+		LeaseTime:   d.Get("lease_time").(string),
+		Preference:  d.Get("preference").(int),
+		RapidCommit: d.Get("rapid_commit").(bool),
+		DhcpOption:  strings.Join(options, ","),
+		Disabled:    d.Get("disabled").(bool),
+	}
+}
+
+func dhcpv6ServerToData(r *client.Dhcpv6Server, d *schema.ResourceData) diag.Diagnostics {
+	options := []string{}
+	for _, option := range strings.Split(r.DhcpOption, ",") {
+		if option != "" {
+			options = append(options, option)
+		}
+	}
+
+	values := map[string]interface{}{
+		"name":         r.Name,
+		"interface":    r.Interface,
+		"address_pool": r.AddressPool,
+		"lease_time":   r.LeaseTime,
+		"preference":   r.Preference,
+		"rapid_commit": r.RapidCommit,
+		"dhcp_options": options,
+		"disabled":     r.Disabled,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
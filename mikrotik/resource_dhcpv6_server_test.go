@@ -0,0 +1,82 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikDhcpv6Server_createAndUpdate(t *testing.T) {
+	if client.IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	name := acctest.RandomWithPrefix("tf-acc-dhcpv6-server")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikDhcpv6ServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpv6Server(name, "3d"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcpv6_server.lan", "address_pool", name),
+					resource.TestCheckResourceAttr("mikrotik_dhcpv6_server.lan", "rapid_commit", "true"),
+				),
+			},
+			{
+				Config: testAccDhcpv6Server(name, "1d"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_dhcpv6_server.lan", "lease_time", "1d"),
+				),
+			},
+			{
+				ResourceName:      "mikrotik_dhcpv6_server.lan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDhcpv6Server(name, leaseTime string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "lan" {
+  name = %[1]q
+}
+
+resource "mikrotik_ipv6_pool" "lan" {
+  name          = %[1]q
+  prefix        = "2001:db8:400::/48"
+  prefix_length = 56
+}
+
+resource "mikrotik_dhcpv6_server" "lan" {
+  name         = %[1]q
+  interface    = mikrotik_bridge_interface.lan.name
+  address_pool = mikrotik_ipv6_pool.lan.name
+  lease_time   = %[2]q
+  disabled     = true
+}
+`, name, leaseTime)
+}
+
+func testAccCheckMikrotikDhcpv6ServerDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_dhcpv6_server" {
+			continue
+		}
+
+		_, err := c.FindDhcpv6Server(rs.Primary.ID)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
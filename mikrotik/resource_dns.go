@@ -0,0 +1,262 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+// dnsSettingsId is the ID of the DNS Settings, a Singleton every Router has
+const dnsSettingsId = "dns"
+
+/**
+ * Define DNS Settings Resource: the `/ip/dns` Singleton, restored to the Router Defaults on Delete
+ */
+func resourceDns() *schema.Resource {
+
+	// Build and Return Resource
+	return &schema.Resource{
+
+		// Resource Description
+		Description: "Manages the DNS server settings of the MikroTik device. Destroying the resource restores the router defaults.",
+
+		// Create Resource Context Method CallBack
+		CreateContext: updateDns,
+
+		// Read Resource Context Method CallBack
+		ReadContext: readDns,
+
+		// Update Resource Context Method CallBack
+		UpdateContext: updateDns,
+
+		// Delete Resource Context Method CallBack
+		DeleteContext: deleteDns,
+
+		// Define Resource State Context Importer
+		Importer: &schema.ResourceImporter{
+
+			// Define State Context (any ID imports the Singleton)
+			StateContext: importDns,
+		},
+
+		// Define Resource Schema
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateIpAddress,
+					DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				},
+				Description: "IPv4 and IPv6 addresses of the upstream DNS servers, in order.",
+			},
+			"allow_remote_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the router answers DNS requests of other hosts.",
+			},
+			"cache_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          2048,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(64)),
+				Description:      "DNS cache size in KiB.",
+			},
+			"cache_max_ttl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "1w",
+				ValidateDiagFunc: validateDuration(),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Longest time a cached answer is kept, whatever its TTL (e.g. `1d`, `1w`).",
+			},
+			"use_doh_server": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPS)),
+				Description:      "URL of the DNS over HTTPS server queries are sent to instead of `servers` (e.g. `https://cloudflare-dns.com/dns-query`).",
+			},
+			"verify_doh_cert": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the certificate of the DNS over HTTPS server is verified.",
+			},
+			"max_concurrent_queries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Maximum number of queries resolved at the same time.",
+			},
+		},
+	}
+}
+
+/**
+ * Read DNS Settings into Resource Data
+ */
+func readDns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get DNS Client
+	c := m.(*client.Mikrotik)
+
+	// Find DNS Settings
+	settings, err := c.FindDnsSettings()
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Convert DNS Settings to Resource Data and put it in Resource Pointer
+	return dnsToData(settings, d)
+}
+
+/**
+ * Set DNS Settings from Resource Data (Create and Update)
+ */
+func updateDns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get DNS Client
+	c := m.(*client.Mikrotik)
+
+	// Set DNS Settings
+	settings, err := c.UpdateDnsSettings(dataToDns(d))
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Convert DNS Settings to Resource Data and put it in Resource Pointer
+	return dnsToData(settings, d)
+}
+
+/**
+ * Restore the Default DNS Settings (the Singleton cannot be removed)
+ */
+func deleteDns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get DNS Client
+	c := m.(*client.Mikrotik)
+
+	// Reset DNS Settings
+	if err := c.ResetDnsSettings(); err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Remove Resource from State
+	d.SetId("")
+
+	// Return Diagnostic
+	return nil
+}
+
+/**
+ * Import the DNS Settings whatever the Import ID
+ */
+func importDns(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	// Set Singleton ID
+	d.SetId(dnsSettingsId)
+
+	// Return Resource Data
+	return []*schema.ResourceData{d}, nil
+}
+
+/**
+ * Function used to Convert Resource Data to DNS Settings
+ */
+func dataToDns(d *schema.ResourceData) *client.DnsSettings {
+
+	// Get Servers
+	servers := []string{}
+	for _, server := range d.Get("servers").([]interface{}) {
+		servers = append(servers, server.(string))
+	}
+
+	// Build and Return DNS Settings
+	return &client.DnsSettings{
+		Servers:              strings.Join(servers, ","),
+		AllowRemoteRequests:  d.Get("allow_remote_requests").(bool),
+		CacheSize:            strconv.Itoa(d.Get("cache_size").(int)),
+		CacheMaxTtl:          d.Get("cache_max_ttl").(string),
+		UseDohServer:         d.Get("use_doh_server").(string),
+		VerifyDohCert:        d.Get("verify_doh_cert").(bool),
+		MaxConcurrentQueries: d.Get("max_concurrent_queries").(int),
+	}
+}
+
+/**
+ * Function used to Convert DNS Settings to Resource Data
+ */
+func dnsToData(settings *client.DnsSettings, d *schema.ResourceData) diag.Diagnostics {
+
+	// Parse Cache Size
+	cacheSize, err := parseCacheSize(settings.CacheSize)
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Initialize Resource ID
+	d.SetId(dnsSettingsId)
+
+	// Split Servers
+	servers := []string{}
+	for _, server := range strings.Split(settings.Servers, ",") {
+		if server != "" {
+			servers = append(servers, server)
+		}
+	}
+
+	// Initialize Fields
+	d.Set("servers", servers)
+	d.Set("allow_remote_requests", settings.AllowRemoteRequests)
+	d.Set("cache_size", cacheSize)
+	d.Set("cache_max_ttl", settings.CacheMaxTtl)
+	d.Set("use_doh_server", settings.UseDohServer)
+	d.Set("verify_doh_cert", settings.VerifyDohCert)
+	d.Set("max_concurrent_queries", settings.MaxConcurrentQueries)
+
+	// Return Diagnostic
+	return nil
+}
+
+/**
+ * Function used to Parse a DNS Cache Size, printed in KiB (e.g. 2048KiB)
+ */
+func parseCacheSize(value string) (int, error) {
+
+	// Parse Size
+	size, err := strconv.Atoi(strings.TrimSuffix(value, "KiB"))
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return 0, fmt.Errorf("cannot parse dns cache size `%s`: expected a size in KiB", value)
+	}
+
+	// Return Size
+	return size, nil
+}
@@ -2,15 +2,18 @@ package mikrotik
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceRecord() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Creates a DNS record on the MikroTik device.",
 
 		CreateContext: resourceServerCreate,
@@ -18,19 +21,105 @@ func resourceRecord() *schema.Resource {
 		UpdateContext: resourceServerUpdate,
 		DeleteContext: resourceServerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/ip/dns/static", "name"),
 		},
 
+		CustomizeDiff: dnsRecordCustomizeDiff,
+
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"name", "regexp"},
+				ValidateDiagFunc: validateName,
+				Description:      "The name of the DNS hostname to be created.",
+			},
+			"regexp": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the DNS hostname to be created.",
+				Optional:    true,
+				Description: "Regular expression the queried names are matched against, instead of `name`.",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "A",
+				ForceNew:         true,
+				ValidateDiagFunc: validateEnum("A", "AAAA", "CNAME", "MX", "TXT", "SRV", "NS", "FWD", "NXDOMAIN"),
+				Description:      "The type of the DNS record: A, AAAA, CNAME, MX, TXT, SRV, NS, FWD or NXDOMAIN. Only the values of this type may be set.",
 			},
 			"address": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateIpAddress,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "The IPv4 (A record) or IPv6 (AAAA record) address to be returned for the DNS hostname.",
+			},
+			"cname": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The A record to be returend from the DNS hostname.",
+				Optional:    true,
+				Description: "The canonical name of a CNAME record.",
+			},
+			"mx_exchange": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The mail server of an MX record.",
+			},
+			"mx_preference": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 65535)),
+				Description:      "The preference of an MX record.",
+			},
+			"text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The text of a TXT record.",
+			},
+			"srv_target": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The target host of an SRV record.",
+			},
+			"srv_port": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validatePort,
+				Description:      "The target port of an SRV record.",
+			},
+			"srv_priority": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 65535)),
+				Description:      "The priority of an SRV record.",
+			},
+			"srv_weight": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 65535)),
+				Description:      "The weight of an SRV record.",
+			},
+			"ns": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name server of an NS record.",
+			},
+			"forward_to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The DNS server the queries of an FWD record are forwarded to.",
+			},
+			"match_subdomain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the record answers the subdomains of `name` too (RouterOS v7).",
+			},
+			"address_list": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The address list the resolved addresses are added to.",
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -38,13 +127,20 @@ func resourceRecord() *schema.Resource {
 				Description: "The comment text associated with the DNS record.",
 			},
 			"ttl": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "The ttl of the DNS record.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "The ttl of the DNS record.",
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceRecordV0(), "/ip/dns/static", "name", "address"),
+	}
+
+	return resource
 }
 
 func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -65,12 +161,8 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	record, err := c.FindDnsRecord(d.Id())
 
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return recordToData(record, d)
@@ -79,13 +171,8 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 
-	currentRecord, err := c.FindDnsRecord(d.Id())
 	record := prepareDnsRecord(d)
-	record.Id = currentRecord.Id
-
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	record.Id = d.Id()
 
 	log.Printf("[DEBUG] About to update dns record with %v", record)
 	dnsRecord, err := c.UpdateDnsRecord(record)
@@ -97,32 +184,105 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	name := d.Id()
-
 	c := m.(*client.Mikrotik)
 
-	record, err := c.FindDnsRecord(name)
+	err := c.DeleteDnsRecord(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// dnsRecordValues lists the attributes holding the values of each record type, the first one is required
+var dnsRecordValues = map[string][]string{
+	"A":        {"address"},
+	"AAAA":     {"address"},
+	"CNAME":    {"cname"},
+	"MX":       {"mx_exchange", "mx_preference"},
+	"TXT":      {"text"},
+	"SRV":      {"srv_target", "srv_port", "srv_priority", "srv_weight"},
+	"NS":       {"ns"},
+	"FWD":      {"forward_to"},
+	"NXDOMAIN": {},
+}
+
+func dnsRecordCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	isSet := func(key string) bool {
+		return !config.IsNull() && !config.GetAttr(key).IsNull()
 	}
-	err = c.DeleteDnsRecord(record.Id)
 
-	if err != nil {
-		return diag.FromErr(err)
+	address := ""
+	if d.NewValueKnown("address") {
+		address = d.Get("address").(string)
 	}
-	d.SetId("")
+
+	return checkDnsRecordValues(d.Get("type").(string), isSet, address)
+}
+
+// checkDnsRecordValues checks that the values of the record type, and only them, are set
+func checkDnsRecordValues(recordType string, isSet func(key string) bool, address string) error {
+	allowed := map[string]bool{}
+	for i, key := range dnsRecordValues[recordType] {
+		if i == 0 && !isSet(key) {
+			return fmt.Errorf("`%s` is required for %s records", key, recordType)
+		}
+		allowed[key] = true
+	}
+
+	for _, values := range dnsRecordValues {
+		for _, key := range values {
+			if !allowed[key] && isSet(key) {
+				return fmt.Errorf("`%s` cannot be set for %s records", key, recordType)
+			}
+		}
+	}
+
+	switch {
+	case address == "":
+	case recordType == "A":
+		if err := checkIpAddress(ipv4Family)(address); err != nil {
+			return fmt.Errorf("`address` of A records: %w", err)
+		}
+	case recordType == "AAAA":
+		if err := checkIpAddress(ipv6Family)(address); err != nil {
+			return fmt.Errorf("`address` of AAAA records: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func recordToData(record *client.DnsRecord, d *schema.ResourceData) diag.Diagnostics {
+	recordType := record.Type
+	if recordType == "" {
+		recordType = "A"
+	}
+
 	values := map[string]interface{}{
-		"name":    record.Name,
-		"address": record.Address,
-		"ttl":     record.Ttl,
+		"name":            record.Name,
+		"regexp":          record.Regexp,
+		"type":            recordType,
+		"address":         record.Address,
+		"cname":           record.Cname,
+		"mx_exchange":     record.MxExchange,
+		"mx_preference":   record.MxPreference,
+		"text":            record.Text,
+		"srv_target":      record.SrvTarget,
+		"srv_port":        record.SrvPort,
+		"srv_priority":    record.SrvPriority,
+		"srv_weight":      record.SrvWeight,
+		"ns":              record.Ns,
+		"forward_to":      record.ForwardTo,
+		"match_subdomain": record.MatchSubdomain == "true" || record.MatchSubdomain == "yes",
+		"address_list":    record.AddressList,
+		"comment":         record.Comment,
+		"ttl":             record.Ttl,
 	}
 
-	d.SetId(record.Name)
+	d.SetId(record.Id)
 
 	var diags diag.Diagnostics
 
@@ -139,9 +299,34 @@ func prepareDnsRecord(d *schema.ResourceData) *client.DnsRecord {
 	dnsRecord := new(client.DnsRecord)
 
 	dnsRecord.Name = d.Get("name").(string)
+	dnsRecord.Regexp = d.Get("regexp").(string)
 	dnsRecord.Ttl = d.Get("ttl").(int)
 	dnsRecord.Address = d.Get("address").(string)
 	dnsRecord.Comment = d.Get("comment").(string)
+	dnsRecord.Cname = d.Get("cname").(string)
+	dnsRecord.MxExchange = d.Get("mx_exchange").(string)
+	dnsRecord.MxPreference = d.Get("mx_preference").(int)
+	dnsRecord.Text = d.Get("text").(string)
+	dnsRecord.SrvTarget = d.Get("srv_target").(string)
+	dnsRecord.SrvPort = d.Get("srv_port").(int)
+	dnsRecord.SrvPriority = d.Get("srv_priority").(int)
+	dnsRecord.SrvWeight = d.Get("srv_weight").(int)
+	dnsRecord.Ns = d.Get("ns").(string)
+	dnsRecord.ForwardTo = d.Get("forward_to").(string)
+	dnsRecord.AddressList = d.Get("address_list").(string)
+
+	// A records are sent without type, which routers before 6.47 do not know
+	if recordType := d.Get("type").(string); recordType != "A" {
+		dnsRecord.Type = recordType
+	}
+
+	// match-subdomain is only sent when used, routers before v7 do not know it
+	if matchSubdomain := d.Get("match_subdomain").(bool); matchSubdomain || d.HasChange("match_subdomain") {
+		dnsRecord.MatchSubdomain = "no"
+		if matchSubdomain {
+			dnsRecord.MatchSubdomain = "yes"
+		}
+	}
 
 	return dnsRecord
 }
@@ -2,6 +2,7 @@ package mikrotik
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -37,7 +38,7 @@ func TestAccMikrotikDnsRecord_createAndPlanWithNonExistantRecord(t *testing.T) {
 	resourceName := "mikrotik_dns_record.bar"
 	removeDnsRecord := func() {
 		c := client.NewClient(client.GetConfigFromEnv())
-		dns, err := c.FindDnsRecord(dnsName)
+		dns, err := c.FindDnsRecordByName(dnsName)
 
 		if err != nil {
 			t.Fatalf("Error finding the DNS record: %s", err)
@@ -150,6 +151,83 @@ func TestAccMikrotikDnsRecord_import(t *testing.T) {
 	})
 }
 
+func TestAccMikrotikDnsRecord_createCname(t *testing.T) {
+	dnsName := internal.GetNewDnsName()
+	target := internal.GetNewDnsName()
+
+	resourceName := "mikrotik_dns_record.bar"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikDnsRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsRecordCname(dnsName, target),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDnsRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "CNAME"),
+					resource.TestCheckResourceAttr(resourceName, "cname", target)),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCheckDnsRecordValues(t *testing.T) {
+	cases := []struct {
+		recordType string
+		set        []string
+		address    string
+		err        string
+	}{
+		{recordType: "A", set: []string{"address"}, address: "192.168.88.1"},
+		{recordType: "A", set: []string{"address"}, address: "2001:db8::1", err: "`address` of A records"},
+		{recordType: "AAAA", set: []string{"address"}, address: "2001:db8::1"},
+		{recordType: "A", set: []string{}, err: "`address` is required for A records"},
+		{recordType: "CNAME", set: []string{"cname"}},
+		{recordType: "CNAME", set: []string{"cname", "address"}, err: "`address` cannot be set for CNAME records"},
+		{recordType: "MX", set: []string{"mx_preference"}, err: "`mx_exchange` is required for MX records"},
+		{recordType: "SRV", set: []string{"srv_target", "srv_port", "srv_weight"}},
+		{recordType: "TXT", set: []string{"text", "srv_port"}, err: "`srv_port` cannot be set for TXT records"},
+		{recordType: "NXDOMAIN", set: []string{}},
+		{recordType: "FWD", set: []string{"forward_to"}},
+	}
+
+	for _, tc := range cases {
+		isSet := func(key string) bool {
+			for _, set := range tc.set {
+				if set == key {
+					return true
+				}
+			}
+			return false
+		}
+
+		err := checkDnsRecordValues(tc.recordType, isSet, tc.address)
+		if tc.err == "" && err != nil {
+			t.Errorf("%s record with %v: unexpected error %v", tc.recordType, tc.set, err)
+		}
+		if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s record with %v: expected error %q, got %v", tc.recordType, tc.set, tc.err, err)
+		}
+	}
+}
+
+func testAccDnsRecordCname(dnsName, target string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_dns_record" "bar" {
+    name = "%s"
+    type = "CNAME"
+    cname = "%s"
+    ttl = "300"
+}
+`, dnsName, target)
+}
+
 func testAccDnsRecord(dnsName, ipAddr string) string {
 	return fmt.Sprintf(`
 resource "mikrotik_dns_record" "bar" {
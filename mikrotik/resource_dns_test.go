@@ -0,0 +1,108 @@
+package mikrotik
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * DNS Settings Resource Test (IPv4 and IPv6 Servers, Update, Import and Reset on Destroy)
+ */
+func TestAccMikrotikDns_setAndReset(t *testing.T) {
+
+	// Initialize Resource Name
+	resourceName := "mikrotik_dns.testacc"
+
+	// Initialize Test (not in parallel, the Settings are shared by the Router)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDnsReset,
+		Steps: []resource.TestStep{
+			{
+				// The IPv6 Server is written the long way, RouterOS prints it compressed
+				Config: testAccDns(`["2001:4860:4860:0:0:0:0:8888", "8.8.8.8"]`, "7d"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "dns"),
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "servers.1", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "allow_remote_requests", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cache_size", "4096"),
+				),
+			},
+			{
+				Config: testAccDns(`["1.1.1.1"]`, "1d"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "servers.0", "1.1.1.1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "dns",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cache_max_ttl"},
+			},
+		},
+	})
+}
+
+/**
+ * Test Method for Parsing the DNS Cache Size printed by the Router
+ */
+func TestParseCacheSize(t *testing.T) {
+	for value, expected := range map[string]int{"2048KiB": 2048, "4096": 4096} {
+		if size, err := parseCacheSize(value); err != nil || size != expected {
+			t.Errorf("parseCacheSize(%q) = %d, %v; expected %d", value, size, err, expected)
+		}
+	}
+
+	// Sizes in other Units are reported rather than read as 0
+	for _, value := range []string{"2MiB", ""} {
+		if _, err := parseCacheSize(value); err == nil {
+			t.Errorf("parseCacheSize(%q): expected an error, got nil", value)
+		}
+	}
+}
+
+/**
+ * Function used to Build the DNS Settings Test Configuration
+ */
+func testAccDns(servers string, cacheMaxTtl string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_dns" "testacc" {
+    servers               = %s
+    allow_remote_requests = true
+    cache_size            = 4096
+    cache_max_ttl         = "%s"
+}
+`, servers, cacheMaxTtl)
+}
+
+/**
+ * Function used to Check the DNS Settings were restored to the Router Defaults
+ */
+func testAccCheckDnsReset(s *terraform.State) error {
+
+	// Read DNS Settings
+	settings, err := apiClient.FindDnsSettings()
+	if err != nil {
+		return err
+	}
+
+	// Check Defaults (the Cache Size is printed in KiB)
+	expected := client.DefaultDnsSettings
+	expected.CacheSize = settings.CacheSize
+	if size, err := parseCacheSize(settings.CacheSize); !reflect.DeepEqual(*settings, expected) || err != nil || size != 2048 {
+		return fmt.Errorf("dns settings were not reset to defaults: %+v", settings)
+	}
+
+	// Return no Error
+	return nil
+}
@@ -0,0 +1,320 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+/**
+ * Define Firewall Address List Resource: every static Entry of a List, written in Batches
+ */
+func resourceFirewallAddressList() *schema.Resource {
+
+	// Build and Return Resource
+	return &schema.Resource{
+
+		// Resource Description
+		Description: "Manages every static entry of a Firewall Address List within MikroTik device. Entries are added, updated and removed in pipelined batches.",
+
+		// Create Resource Context Method CallBack
+		CreateContext: createFirewallAddressList,
+
+		// Read Resource Context Method CallBack
+		ReadContext: readFirewallAddressList,
+
+		// Update Resource Context Method CallBack
+		UpdateContext: updateFirewallAddressList,
+
+		// Delete Resource Context Method CallBack
+		DeleteContext: deleteFirewallAddressList,
+
+		// Define Resource State Context Importer
+		Importer: &schema.ResourceImporter{
+
+			// Define State Context (the ID is the List Name)
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Define Resource Schema
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Firewall Address List Name.",
+			},
+			"addresses": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateFirewallListAddress,
+				},
+				Set:         hashFirewallListAddress,
+				Description: "Firewall Address List Addresses (IP addresses, prefixes, ranges or DNS names). Host prefixes (e.g. `10.0.0.1/32`) are the same as their address.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Firewall Address List Entries Comment.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Firewall Address List Entries Disabled.",
+			},
+		},
+	}
+}
+
+/**
+ * Function used to Create Firewall Address List
+ */
+func createFirewallAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Firewall Address List Client
+	c := m.(*client.Mikrotik)
+
+	// Add every Entry in one Batch
+	_, err := c.AddFirewallAddressListEntries(dataToFirewallAddressListEntries(d, dataToFirewallAddressListAddresses(d)))
+
+	// Set ID (the added Entries are tracked even if others failed)
+	d.SetId(d.Get("name").(string))
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Reload Firewall Address List
+	return readFirewallAddressList(ctx, d, m)
+}
+
+/**
+ * Function used to Read Firewall Address List
+ */
+func readFirewallAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Define Diagnostic variable
+	var diags diag.Diagnostics
+
+	// Get Firewall Address List Client
+	c := m.(*client.Mikrotik)
+
+	// List Entries
+	entries, err := c.ListFirewallAddressList(d.Id())
+
+	// If List has no static Entry anymore
+	if err == nil && len(entries) == 0 {
+
+		// Report List as Not Found
+		err = client.NewNotFound(fmt.Sprintf("firewall address list `%s` not found", d.Id()))
+	}
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return readError(d, err)
+	}
+
+	// Convert Entries to Resource Data and put it in Resource Pointer
+	firewallAddressListToData(d.Id(), entries, d)
+
+	// Return Diagnistic
+	return diags
+}
+
+/**
+ * Function used to Update Firewall Address List: missing Addresses are added, extra ones removed, and
+ * the kept ones updated when the Comment or the Disabled Flag changed
+ */
+func updateFirewallAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get Firewall Address List Client
+	c := m.(*client.Mikrotik)
+
+	// List current Entries
+	entries, err := c.ListFirewallAddressList(d.Id())
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Get wanted Addresses
+	wanted := map[string]bool{}
+	for _, address := range dataToFirewallAddressListAddresses(d) {
+		wanted[address] = true
+	}
+
+	// Sort current Entries into Entries to Remove and Entries to Update
+	removed := []string{}
+	updated := []client.FirewallAddressListEntry{}
+	comment, disabled := d.Get("comment").(string), d.Get("disabled").(bool)
+	for _, entry := range entries {
+		switch {
+		case !wanted[entry.Address]:
+			removed = append(removed, entry.Id)
+		case entry.Comment != comment || entry.Disabled != disabled:
+			entry.Comment, entry.Disabled = comment, disabled
+			updated = append(updated, entry)
+		}
+		delete(wanted, entry.Address)
+	}
+
+	// Get Addresses to Add
+	added := []string{}
+	for address := range wanted {
+		added = append(added, address)
+	}
+	sort.Strings(added)
+
+	// Remove Entries in one Batch
+	if err := c.DeleteFirewallAddressListEntries(removed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Update Entries in one Batch
+	if err := c.UpdateFirewallAddressListEntries(updated); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Add Entries in one Batch
+	if _, err := c.AddFirewallAddressListEntries(dataToFirewallAddressListEntries(d, added)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Reload Firewall Address List
+	return readFirewallAddressList(ctx, d, m)
+}
+
+/**
+ * Function used to Delete Firewall Address List
+ */
+func deleteFirewallAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Define Diagnostic variable
+	var diags diag.Diagnostics
+
+	// Get Firewall Address List Client
+	c := m.(*client.Mikrotik)
+
+	// List Entries
+	entries, err := c.ListFirewallAddressList(d.Id())
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return deleteError(d, err)
+	}
+
+	// Collect Entry IDs
+	ids := []string{}
+	for _, entry := range entries {
+		ids = append(ids, entry.Id)
+	}
+
+	// Remove every Entry in one Batch
+	if err := c.DeleteFirewallAddressListEntries(ids); err != nil {
+
+		// Return Error
+		return deleteError(d, err)
+	}
+
+	// Return Diagnistic
+	return diags
+}
+
+/**
+ * Function used to get the sorted Addresses of the Resource Data
+ */
+func dataToFirewallAddressListAddresses(d *schema.ResourceData) []string {
+
+	// Initialize Addresses
+	addresses := []string{}
+
+	// For each Address
+	for _, address := range d.Get("addresses").(*schema.Set).List() {
+		addresses = append(addresses, normalize.ListAddress(address.(string)))
+	}
+
+	// Sort Addresses (Batches are then run in a stable Order)
+	sort.Strings(addresses)
+
+	// Return Addresses
+	return addresses
+}
+
+/**
+ * Function used to build the Entries of the given Addresses from the Resource Data
+ */
+func dataToFirewallAddressListEntries(d *schema.ResourceData, addresses []string) []client.FirewallAddressListEntry {
+
+	// Initialize Entries
+	entries := []client.FirewallAddressListEntry{}
+
+	// For each Address
+	for _, address := range addresses {
+		entries = append(entries, client.FirewallAddressListEntry{
+			List:     d.Get("name").(string),
+			Address:  address,
+			Comment:  d.Get("comment").(string),
+			Disabled: d.Get("disabled").(bool),
+		})
+	}
+
+	// Return Entries
+	return entries
+}
+
+/**
+ * Function used to put the Entries of a Firewall Address List in the Resource Data
+ */
+func firewallAddressListToData(list string, entries []client.FirewallAddressListEntry, d *schema.ResourceData) {
+
+	// Collect Addresses
+	addresses := []interface{}{}
+	for _, entry := range entries {
+		addresses = append(addresses, entry.Address)
+	}
+
+	// Get Comment and Disabled Flag (an Entry that drifted is reported, so that the next Update fixes it)
+	comment, disabled := d.Get("comment").(string), d.Get("disabled").(bool)
+	for _, entry := range entries {
+		if entry.Comment != comment || entry.Disabled != disabled {
+			comment, disabled = entry.Comment, entry.Disabled
+			break
+		}
+	}
+
+	// Set Values
+	d.Set("name", list)
+	d.Set("addresses", schema.NewSet(hashFirewallListAddress, addresses))
+	d.Set("comment", comment)
+	d.Set("disabled", disabled)
+}
+
+/**
+ * Function used to Hash an Address List Entry in its RouterOS Form, so that `10.0.0.1/32` and `10.0.0.1` are the same Element
+ */
+func hashFirewallListAddress(value interface{}) int {
+
+	// Hash Normalized Address
+	return schema.HashString(normalize.ListAddress(value.(string)))
+}
@@ -0,0 +1,179 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Firewall Address List Resource Create, Update and Import Test
+ */
+func TestFirewallAddressList_CreateUpdateImport(t *testing.T) {
+
+	// Initialize Resource Name
+	resourceName := "mikrotik_firewall_address_list.testacc"
+
+	// Initialize Addresses (enough Entries to be written in several Batch Windows)
+	addresses := []string{}
+	for i := 1; i <= 40; i++ {
+		addresses = append(addresses, fmt.Sprintf("10.98.0.%d", i))
+	}
+
+	// Initialize Updated Addresses (a host prefix is printed as an address by RouterOS and must not show a diff)
+	updatedAddresses := append([]string{"10.98.1.0/24", "10.98.2.1/32"}, addresses[10:]...)
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Check destroy Callback
+		CheckDestroy: testAccCheckFirewallAddressListDestroy,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Resource
+				Config: testAccFirewallAddressList("tf-acc-list", addresses, "created"),
+
+				// Check Test Result
+				Check: resource.ComposeTestCheckFunc(
+					testAccFirewallAddressListEntries(resourceName, len(addresses), "created"),
+					resource.TestCheckResourceAttr(resourceName, "id", "tf-acc-list"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", fmt.Sprint(len(addresses))),
+				),
+			},
+			{
+				// Configure Updated Test Resource (removes, adds and updates Entries)
+				Config: testAccFirewallAddressList("tf-acc-list", updatedAddresses, "updated"),
+
+				// Check Test Result
+				Check: resource.ComposeTestCheckFunc(
+					testAccFirewallAddressListEntries(resourceName, len(updatedAddresses), "updated"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", fmt.Sprint(len(updatedAddresses))),
+					resource.TestCheckResourceAttr(resourceName, "comment", "updated"),
+				),
+			},
+			{
+				// Import Test Resource
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+/**
+ * Function used to Check the static Entries of the Address List on the Router
+ */
+func testAccFirewallAddressListEntries(resourceName string, count int, comment string) resource.TestCheckFunc {
+
+	// Find and return result
+	return func(s *terraform.State) error {
+
+		// Find resource
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		// If not OK
+		if !ok {
+
+			// Return Not Found Error Message
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		// Build Client
+		c := client.NewClient(client.GetConfigFromEnv())
+
+		// List Entries
+		entries, err := c.ListFirewallAddressList(rs.Primary.ID)
+
+		// If there are Error
+		if err != nil {
+
+			// Return Formatted Error Message
+			return fmt.Errorf("Unable to get remote entries for %s: %v", resourceName, err)
+		}
+
+		// If Entry Count does not match
+		if len(entries) != count {
+
+			// Return Formatted Message
+			return fmt.Errorf("expected %d entries in %s, got %d", count, rs.Primary.ID, len(entries))
+		}
+
+		// Check Comments
+		for _, entry := range entries {
+			if entry.Comment != comment {
+				return fmt.Errorf("entry %s has comment `%s`, expected `%s`", entry.Address, entry.Comment, comment)
+			}
+		}
+
+		// Return Null
+		return nil
+	}
+}
+
+/**
+ * Function used to Test if Terraform Resource is Destroyed
+ */
+func testAccCheckFirewallAddressListDestroy(s *terraform.State) error {
+
+	// Build Client
+	c := client.NewClient(client.GetConfigFromEnv())
+
+	// Iterate over Resources
+	for _, rs := range s.RootModule().Resources {
+
+		// If Resource is not Firewall Address List
+		if rs.Type != "mikrotik_firewall_address_list" {
+
+			// Continue Iteration
+			continue
+		}
+
+		// List Entries
+		entries, err := c.ListFirewallAddressList(rs.Primary.ID)
+
+		// If there is Error
+		if err != nil {
+
+			// Return Error
+			return err
+		}
+
+		// If Entries Exist
+		if len(entries) > 0 {
+
+			// Return Formatted Error
+			return fmt.Errorf("remote address list (%s) still has %d entries", rs.Primary.ID, len(entries))
+		}
+	}
+
+	// Return nil
+	return nil
+}
+
+/**
+ * Function used to build Terraform Resource Configuration
+ */
+func testAccFirewallAddressList(name string, addresses []string, comment string) string {
+
+	// Return Resource Configuration
+	return fmt.Sprintf(`
+resource "mikrotik_firewall_address_list" "testacc" {
+	name      = %q
+	addresses = ["%s"]
+	comment   = %q
+}
+`, name, strings.Join(addresses, `", "`), comment)
+}
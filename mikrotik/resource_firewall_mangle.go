@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceFirewallMangle() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/firewall/mangle", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,46 +46,53 @@ func resourceFirewallMangle() *schema.Resource {
 				Computed: true,
 			},
 			"chain": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "Firewall Mangle Chain.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Firewall Mangle Chain.",
 			},
 			"source_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Source Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallAddress,
+				Description:      "Firewall Mangle Source Address.",
 			},
 			"source_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Source Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Mangle Source Port.",
 			},
 			"destination_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Destination Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallAddress,
+				Description:      "Firewall Mangle Destination Address.",
 			},
 			"destination_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Destination Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Mangle Destination Port.",
 			},
 			"any_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Any Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Mangle Any Port.",
 			},
 			"protocol": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Protocol.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallProtocol,
+				Description:      "Firewall Mangle Protocol.",
 			},
 			"in_interface": {
 				Type:        schema.TypeString,
@@ -135,10 +143,11 @@ func resourceFirewallMangle() *schema.Resource {
 				Description: "Firewall Mangle Routing Table.",
 			},
 			"connection_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Connection Type.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateString(checkOptional(checkNegatable(checkEnum("ftp", "h323", "irc", "pptp", "quake3", "sip", "tftp")))),
+				Description:      "Firewall Mangle Connection Type.",
 			},
 			"source_address_list": {
 				Type:        schema.TypeString,
@@ -159,10 +168,12 @@ func resourceFirewallMangle() *schema.Resource {
 				Description: "Firewall Mangle Layer 7 Protocol.",
 			},
 			"source_mac_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Source Mac Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallMacAddress,
+				StateFunc:        normalize.StateFunc(normalize.MacAddress),
+				Description:      "Firewall Mangle Source Mac Address.",
 			},
 			"ipsec_policy": {
 				Type:        schema.TypeString,
@@ -195,11 +206,12 @@ func resourceFirewallMangle() *schema.Resource {
 				Description: "Firewall Mangle Out Bridge Port List.",
 			},
 			"action": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Default:     "accept",
-				Description: "Firewall Mangle Action.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				Default:          "accept",
+				ValidateDiagFunc: validateEnum("accept", "add-dst-to-address-list", "add-src-to-address-list", "change-dscp", "change-mss", "change-ttl", "clear-df", "fasttrack-connection", "jump", "log", "mark-connection", "mark-packet", "mark-routing", "passthrough", "return", "route", "set-priority", "sniff-pc", "sniff-tzsp", "strip-ipv4-options"),
+				Description:      "Firewall Mangle Action.",
 			},
 			"log_prefix": {
 				Type:        schema.TypeString,
@@ -216,22 +228,25 @@ func resourceFirewallMangle() *schema.Resource {
 				Description: "Firewall Mangle Log.",
 			},
 			"connection_state": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Connection State.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateString(checkOptional(checkNegatable(checkList(checkEnum("established", "invalid", "new", "related", "untracked"))))),
+				Description:      "Firewall Mangle Connection State.",
 			},
 			"connection_nat_state": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle Connection NAT State.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateString(checkOptional(checkNegatable(checkList(checkEnum("dstnat", "srcnat"))))),
+				Description:      "Firewall Mangle Connection NAT State.",
 			},
 			"tcp_flags": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Mangle TCP Flags.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateString(checkOptional(checkList(checkNegatable(checkEnum("ack", "cwr", "ece", "fin", "psh", "rst", "syn", "urg"))))),
+				Description:      "Firewall Mangle TCP Flags.",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -289,7 +304,7 @@ func readFirewallMangle(ctx context.Context, d *schema.ResourceData, m interface
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert Firewall Mangle to Resource Data and put it in Resource Pointer
@@ -345,7 +360,7 @@ func deleteFirewallMangle(ctx context.Context, d *schema.ResourceData, m interfa
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
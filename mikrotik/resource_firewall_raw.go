@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceFirewallRaw() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/firewall/raw", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,46 +46,53 @@ func resourceFirewallRaw() *schema.Resource {
 				Computed: true,
 			},
 			"chain": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "Firewall Raw Chain.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Firewall Raw Chain.",
 			},
 			"source_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Raw Source Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallAddress,
+				Description:      "Firewall Raw Source Address.",
 			},
 			"source_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Raw Source Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Raw Source Port.",
 			},
 			"destination_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Raw Destination Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallAddress,
+				Description:      "Firewall Raw Destination Address.",
 			},
 			"destination_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Raw Destination Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Raw Destination Port.",
 			},
 			"any_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Raw Any Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Raw Any Port.",
 			},
 			"protocol": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Raw Protocol.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallProtocol,
+				Description:      "Firewall Raw Protocol.",
 			},
 			"in_interface": {
 				Type:        schema.TypeString,
@@ -123,10 +131,12 @@ func resourceFirewallRaw() *schema.Resource {
 				Description: "Firewall Raw Destination Address List.",
 			},
 			"source_mac_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Raw Source Mac Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallMacAddress,
+				StateFunc:        normalize.StateFunc(normalize.MacAddress),
+				Description:      "Firewall Raw Source Mac Address.",
 			},
 			"ipsec_policy": {
 				Type:        schema.TypeString,
@@ -135,11 +145,12 @@ func resourceFirewallRaw() *schema.Resource {
 				Description: "Firewall Raw IPSec Policy.",
 			},
 			"action": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Default:     "accept",
-				Description: "Firewall Raw Action.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				Default:          "accept",
+				ValidateDiagFunc: validateEnum("accept", "add-dst-to-address-list", "add-src-to-address-list", "drop", "jump", "log", "notrack", "return"),
+				Description:      "Firewall Raw Action.",
 			},
 			"log_prefix": {
 				Type:        schema.TypeString,
@@ -211,7 +222,7 @@ func readFirewallRaw(ctx context.Context, d *schema.ResourceData, m interface{})
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert Firewall Raw to Resource Data and put it in Resource Pointer
@@ -267,7 +278,7 @@ func deleteFirewallRaw(ctx context.Context, d *schema.ResourceData, m interface{
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceFirewallRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/firewall/filter", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,46 +46,53 @@ func resourceFirewallRule() *schema.Resource {
 				Computed: true,
 			},
 			"chain": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "Firewall Rule Chain.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Firewall Rule Chain.",
 			},
 			"source_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Source Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallAddress,
+				Description:      "Firewall Rule Source Address.",
 			},
 			"source_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Source Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Rule Source Port.",
 			},
 			"destination_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Destination Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallAddress,
+				Description:      "Firewall Rule Destination Address.",
 			},
 			"destination_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Destination Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Rule Destination Port.",
 			},
 			"any_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Any Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validatePort,
+				Description:      "Firewall Rule Any Port.",
 			},
 			"protocol": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Protocol.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallProtocol,
+				Description:      "Firewall Rule Protocol.",
 			},
 			"in_interface": {
 				Type:        schema.TypeString,
@@ -135,10 +143,11 @@ func resourceFirewallRule() *schema.Resource {
 				Description: "Firewall Rule Routing Table.",
 			},
 			"connection_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Connection Type.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateString(checkOptional(checkNegatable(checkEnum("ftp", "h323", "irc", "pptp", "quake3", "sip", "tftp")))),
+				Description:      "Firewall Rule Connection Type.",
 			},
 			"source_address_list": {
 				Type:        schema.TypeString,
@@ -159,10 +168,12 @@ func resourceFirewallRule() *schema.Resource {
 				Description: "Firewall Rule Layer 7 Protocol.",
 			},
 			"source_mac_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Description: "Firewall Rule Source Mac Address.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				ValidateDiagFunc: validateFirewallMacAddress,
+				StateFunc:        normalize.StateFunc(normalize.MacAddress),
+				Description:      "Firewall Rule Source Mac Address.",
 			},
 			"ipsec_policy": {
 				Type:        schema.TypeString,
@@ -195,11 +206,12 @@ func resourceFirewallRule() *schema.Resource {
 				Description: "Firewall Rule Out Bridge Port List.",
 			},
 			"action": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				Default:     "accept",
-				Description: "Firewall Rule Action.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				Default:          "accept",
+				ValidateDiagFunc: validateEnum("accept", "add-dst-to-address-list", "add-src-to-address-list", "drop", "fasttrack-connection", "jump", "log", "passthrough", "reject", "return", "tarpit"),
+				Description:      "Firewall Rule Action.",
 			},
 			"log_prefix": {
 				Type:        schema.TypeString,
@@ -271,7 +283,7 @@ func readFirewallRule(ctx context.Context, d *schema.ResourceData, m interface{}
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert Firewall Rule to Resource Data and put it in Resource Pointer
@@ -327,7 +339,7 @@ func deleteFirewallRule(ctx context.Context, d *schema.ResourceData, m interface
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
@@ -69,6 +69,46 @@ func TestFirewallRule_Create(t *testing.T) {
 	})
 }
 
+/**
+ * Firewall Rule Resource Deleted out of band Test
+ */
+func TestFirewallRule_Disappears(t *testing.T) {
+
+	// Initialize Resource Name
+	resourceName := "mikrotik_firewall_rule.testacc"
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Check destroy Callback
+		CheckDestroy: testAccCheckFirewallRuleDestroy,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Resource
+				Config: testAccFirewallRule("input", false),
+
+				// Delete the Rule behind Terraform's back (twice, the second delete finds nothing)
+				Check: resource.ComposeTestCheckFunc(
+					testAccFirewallRuleExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceFirewallRule(), resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceFirewallRule(), resourceName),
+				),
+
+				// The Refresh drops the Rule, so the Plan recreates it
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 /**
  * Function used to Test if Terraform Resource Exists
  */
@@ -9,7 +9,7 @@ import (
 )
 
 func resourceInterfaceList() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Allows to define set of interfaces for easier interface management.",
 
 		CreateContext: resourceInterfaceListCreate,
@@ -18,14 +18,17 @@ func resourceInterfaceList() *schema.Resource {
 		DeleteContext: resourceInterfaceListDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/interface/list", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the interface list.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the interface list.",
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -34,6 +37,12 @@ func resourceInterfaceList() *schema.Resource {
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceInterfaceListV0(), "/interface/list", "name"),
+	}
+
+	return resource
 }
 
 func resourceInterfaceListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -43,7 +52,7 @@ func resourceInterfaceListCreate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(record.Name)
+	d.SetId(record.Id)
 
 	return resourceInterfaceListRead(ctx, d, m)
 }
@@ -52,7 +61,7 @@ func resourceInterfaceListRead(ctx context.Context, d *schema.ResourceData, m in
 	c := m.(*client.Mikrotik)
 	record, err := c.FindInterfaceList(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return recordInterfaceListToData(record, d)
@@ -72,7 +81,6 @@ func resourceInterfaceListUpdate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(r.Name)
 
 	return resourceInterfaceListRead(ctx, d, m)
 }
@@ -81,7 +89,7 @@ func resourceInterfaceListDelete(ctx context.Context, d *schema.ResourceData, m
 	c := m.(*client.Mikrotik)
 	err := c.DeleteInterfaceList(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	return nil
@@ -106,7 +114,7 @@ func recordInterfaceListToData(r *client.InterfaceList, d *schema.ResourceData)
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	d.SetId(r.Name)
+	d.SetId(r.Id)
 
 	return diags
 }
@@ -18,7 +18,7 @@ func resourceInterfaceListMember() *schema.Resource {
 		DeleteContext: resourceInterfaceListMemberDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/interface/list/member", ".id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,13 +27,15 @@ func resourceInterfaceListMember() *schema.Resource {
 				Computed: true,
 			},
 			"interface": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
 			},
 
 			"list": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
 			},
 		},
 	}
@@ -54,12 +56,8 @@ func resourceInterfaceListMemberCreate(ctx context.Context, d *schema.ResourceDa
 func resourceInterfaceListMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Mikrotik)
 	record, err := c.FindInterfaceListMember(d.Id())
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return recordInterfaceListMemberToData(record, d)
@@ -80,7 +78,7 @@ func resourceInterfaceListMemberDelete(ctx context.Context, d *schema.ResourceDa
 	c := m.(*client.Mikrotik)
 	err := c.DeleteInterfaceListMember(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 	return nil
 }
@@ -36,6 +36,26 @@ func TestInterfaceList_basic(t *testing.T) {
 	})
 }
 
+func TestInterfaceList_disappears(t *testing.T) {
+	resourceName := "mikrotik_interface_list.testacc"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckInterfaceListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceList("disappearing_list", "deleted out of band"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccInterfaceListExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceInterfaceList(), resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceInterfaceList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckInterfaceListDestroy(s *terraform.State) error {
 	c := client.NewClient(client.GetConfigFromEnv())
 	for _, rs := range s.RootModule().Resources {
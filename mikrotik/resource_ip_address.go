@@ -16,21 +16,26 @@ func resourceIpAddress() *schema.Resource {
 		ReadContext:   resourceIpAddressRead,
 		UpdateContext: resourceIpAddressUpdate,
 		DeleteContext: resourceIpAddressDelete,
+		CustomizeDiff: addressPlanCustomizeDiff(checkIpAddressPlan, "address", "interface"),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaults(importStateByNaturalKey("/ip/address", ".id"), map[string]interface{}{
+				"conflict_check": conflictCheckOff,
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The IP address and netmask of the interface using slash notation.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpv4Prefix,
+				Description:      "The IP address and netmask of the interface using slash notation.",
 			},
 			"comment": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The comment for the IP address assignment.",
 			},
+			"conflict_check": conflictCheckSchema(),
 			"disabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -38,9 +43,10 @@ func resourceIpAddress() *schema.Resource {
 				Description: "Whether to disable IP address.",
 			},
 			"interface": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The interface on which the IP address is assigned.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "The interface on which the IP address is assigned.",
 			},
 			"network": {
 				Type:        schema.TypeString,
@@ -55,6 +61,10 @@ func resourceIpAddressCreate(ctx context.Context, d *schema.ResourceData, m inte
 	ipAddress := prepareIpAddress(d)
 
 	c := m.(*client.Mikrotik)
+	diags := addressPlanApplyCheck(c, d, checkIpAddressPlan)
+	if diags.HasError() {
+		return diags
+	}
 
 	ipaddr, err := c.AddIpAddress(ipAddress)
 
@@ -62,7 +72,7 @@ func resourceIpAddressCreate(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	return addrToData(ipaddr, d)
+	return append(diags, addrToData(ipaddr, d)...)
 }
 
 func resourceIpAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -70,16 +80,8 @@ func resourceIpAddressRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	ipaddr, err := c.FindIpAddress(d.Id())
 
-	// Clear the state if the error represents that the resource no longer exists
-	_, resourceMissing := err.(*client.NotFound)
-	if resourceMissing && err != nil {
-		d.SetId("")
-		return nil
-	}
-
-	// Make sure all other errors are propagated
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return addrToData(ipaddr, d)
@@ -90,6 +92,10 @@ func resourceIpAddressUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	ipAddress := prepareIpAddress(d)
 	ipAddress.Id = d.Id()
+	diags := addressPlanApplyCheck(c, d, checkIpAddressPlan)
+	if diags.HasError() {
+		return diags
+	}
 
 	ipaddr, err := c.UpdateIpAddress(ipAddress)
 
@@ -97,7 +103,7 @@ func resourceIpAddressUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	return addrToData(ipaddr, d)
+	return append(diags, addrToData(ipaddr, d)...)
 }
 
 func resourceIpAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -106,7 +112,7 @@ func resourceIpAddressDelete(ctx context.Context, d *schema.ResourceData, m inte
 	err := c.DeleteIpAddress(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	d.SetId("")
@@ -2,6 +2,7 @@ package mikrotik
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -95,6 +96,30 @@ func TestAccMikrotikResourceIpAddress_updateAddr(t *testing.T) {
 	})
 }
 
+func TestAccMikrotikResourceIpAddress_conflictCheck(t *testing.T) {
+	bridgeName := acctest.RandomWithPrefix("tf-acc-bridge")
+
+	resourceName := "mikrotik_ip_address.other"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikIpAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpAddressConflictCheck(bridgeName, "192.168.213.1/24", "192.168.214.1/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccIpAddressExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "conflict_check", "error"),
+				),
+			},
+			{
+				Config:      testAccIpAddressConflictCheck(bridgeName, "192.168.213.1/24", "192.168.213.129/25"),
+				ExpectError: regexp.MustCompile("overlaps the address 192.168.213.1/24"),
+			},
+		},
+	})
+}
+
 func testAccIpAddress(ipAddr, ifName, comment string) string {
 	return fmt.Sprintf(`
 resource "mikrotik_ip_address" "test" {
@@ -116,6 +141,31 @@ resource "mikrotik_ip_address" "test" {
 `, ipAddr, ifName, comment, disabled)
 }
 
+func testAccIpAddressConflictCheck(bridgeName, ipAddr, otherIpAddr string) string {
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "test" {
+	name = "%[1]s"
+}
+
+resource "mikrotik_bridge_interface" "other" {
+	name = "%[1]s-other"
+}
+
+resource "mikrotik_ip_address" "test" {
+	address = "%[2]s"
+	interface = mikrotik_bridge_interface.test.name
+}
+
+resource "mikrotik_ip_address" "other" {
+	address = "%[3]s"
+	interface = mikrotik_bridge_interface.other.name
+	conflict_check = "error"
+
+	depends_on = [mikrotik_ip_address.test]
+}
+`, bridgeName, ipAddr, otherIpAddr)
+}
+
 func testAccIpAddressExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
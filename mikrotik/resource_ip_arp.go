@@ -0,0 +1,138 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+func resourceIpArp() *schema.Resource {
+	return &schema.Resource{
+		Description: "Pins the MAC address of an IP address with a static ARP entry, e.g. for hosts behind an interface in `reply-only` ARP mode.",
+
+		CreateContext: resourceIpArpCreate,
+		ReadContext:   resourceIpArpRead,
+		UpdateContext: resourceIpArpUpdate,
+		DeleteContext: resourceIpArpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByNaturalKey("/ip/arp", ".id"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpv4Address,
+				Description:      "IP address of the entry.",
+			},
+			"mac_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateMacAddress,
+				StateFunc:        normalize.StateFunc(normalize.MacAddress),
+				Description:      "MAC address the IP address resolves to.",
+			},
+			"interface": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface the host is reached through.",
+			},
+			"published": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the router answers ARP requests for the address on behalf of the host (proxy ARP of a single address).",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the entry is disabled.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the entry.",
+			},
+		},
+	}
+}
+
+func resourceIpArpCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddIpArp(dataToIpArp(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceIpArpRead(ctx, d, m)
+}
+
+func resourceIpArpRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindIpArp(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return ipArpToData(record, d)
+}
+
+func resourceIpArpUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateIpArp(dataToIpArp(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIpArpRead(ctx, d, m)
+}
+
+func resourceIpArpDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteIpArp(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+func dataToIpArp(d *schema.ResourceData) *client.IpArp {
+	return &client.IpArp{
+		Id:         d.Id(),
+		Address:    d.Get("address").(string),
+		MacAddress: d.Get("mac_address").(string),
+		Interface:  d.Get("interface").(string),
+		Published:  d.Get("published").(bool),
+		Disabled:   d.Get("disabled").(bool),
+		Comment:    d.Get("comment").(string),
+	}
+}
+
+func ipArpToData(r *client.IpArp, d *schema.ResourceData) diag.Diagnostics {
+	values := map[string]interface{}{
+		"address":     r.Address,
+		"mac_address": r.MacAddress,
+		"interface":   r.Interface,
+		"published":   r.Published,
+		"disabled":    r.Disabled,
+		"comment":     r.Comment,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
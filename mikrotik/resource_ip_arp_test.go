@@ -0,0 +1,75 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikIpArp_createAndUpdate(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-arp")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikIpArpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpArp(name, "02:00:00:21:20:01", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_bridge_interface.lan", "arp", "reply-only"),
+					resource.TestCheckResourceAttr("mikrotik_ip_arp.printer", "mac_address", "02:00:00:21:20:01"),
+					resource.TestCheckResourceAttr("mikrotik_ip_arp.printer", "published", "false"),
+				),
+			},
+			{
+				Config: testAccIpArp(name, "02:00:00:21:20:02", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_ip_arp.printer", "mac_address", "02:00:00:21:20:02"),
+					resource.TestCheckResourceAttr("mikrotik_ip_arp.printer", "published", "true"),
+				),
+			},
+			{
+				ResourceName:      "mikrotik_ip_arp.printer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIpArp(name, macAddress string, published bool) string {
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "lan" {
+  name = %[1]q
+  arp  = "reply-only"
+}
+
+resource "mikrotik_ip_arp" "printer" {
+  address     = "10.212.0.10"
+  mac_address = %[2]q
+  interface   = mikrotik_bridge_interface.lan.name
+  published   = %[3]t
+  comment     = "printer"
+}
+`, name, macAddress, published)
+}
+
+func testAccCheckMikrotikIpArpDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_ip_arp" {
+			continue
+		}
+
+		_, err := c.FindIpArp(rs.Primary.ID)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
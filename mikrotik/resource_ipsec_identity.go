@@ -35,7 +35,7 @@ func resourceIpSecIdentity() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/ipsec/identity", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,16 +45,18 @@ func resourceIpSecIdentity() *schema.Resource {
 				Computed: true,
 			},
 			"peer": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Identity Peer.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "IPSec Identity Peer.",
 			},
 			"auth_method": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "pre-shared-key",
-				Description: "IPSec Identity Diffie-Hellman Group.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "pre-shared-key",
+				ValidateDiagFunc: validateEnum("digital-signature", "eap", "eap-radius", "pre-shared-key", "pre-shared-key-xauth", "rsa-key", "rsa-signature-hybrid"),
+				Description:      "IPSec Identity Diffie-Hellman Group.",
 			},
 			"secret": {
 				Type:        schema.TypeString,
@@ -119,10 +121,11 @@ func resourceIpSecIdentity() *schema.Resource {
 				Description: "IPSec Identity Remote ID",
 			},
 			"match_by": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "IPSec Identity Match By",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateString(checkOptional(checkEnum("certificate", "remote-id"))),
+				Description:      "IPSec Identity Match By",
 			},
 			"mode_config": {
 				Type:        schema.TypeString,
@@ -130,10 +133,11 @@ func resourceIpSecIdentity() *schema.Resource {
 				Description: "IPSec Identity Mode Config",
 			},
 			"generate_policy": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "no",
-				Description: "IPSec Identity Generate Policy",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "no",
+				ValidateDiagFunc: validateEnum("no", "port-override", "port-strict"),
+				Description:      "IPSec Identity Generate Policy",
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -197,7 +201,7 @@ func readIpSecIdentity(ctx context.Context, d *schema.ResourceData, m interface{
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert IPSec Identity to Resource Data and put it in Resource Pointer
@@ -253,7 +257,7 @@ func deleteIpSecIdentity(ctx context.Context, d *schema.ResourceData, m interfac
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceIpSecPeer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/ipsec/peer", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,28 +46,33 @@ func resourceIpSecPeer() *schema.Resource {
 				Computed: true,
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Peer Name.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "IPSec Peer Name.",
 			},
 			"address": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Peer Address.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateIpAddressOrPrefix,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "IPSec Peer Address.",
 			},
 			"profile": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Peer Profile.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "IPSec Peer Profile.",
 			},
 			"exchange_mode": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "ike2",
-				Description: "IPSec Peer Max Failure (in minute).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ike2",
+				ValidateDiagFunc: validateEnum("aggressive", "base", "ike2", "main"),
+				Description:      "IPSec Peer Max Failure (in minute).",
 			},
 			"send_initial_contact": {
 				Type:        schema.TypeBool,
@@ -81,16 +87,18 @@ func resourceIpSecPeer() *schema.Resource {
 				Description: "IPSec Peer Passive.",
 			},
 			"local_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "IPSec Peer Local Address",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateString(checkOptional(checkIpAddress(ipAnyFamily))),
+				Description:      "IPSec Peer Local Address",
 			},
 			"port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     0,
-				Description: "IPSec Peer Port",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validatePort,
+				Description:      "IPSec Peer Port",
 			},
 		},
 	}
@@ -142,7 +150,7 @@ func readIpSecPeer(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert IPSec Peer to Resource Data and put it in Resource Pointer
@@ -198,7 +206,7 @@ func deleteIpSecPeer(ctx context.Context, d *schema.ResourceData, m interface{})
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceIpSecPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/ipsec/policy", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,10 +46,11 @@ func resourceIpSecPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"peer": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Policy Peer.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "IPSec Policy Peer.",
 			},
 			"tunnel": {
 				Type:        schema.TypeBool,
@@ -57,34 +59,41 @@ func resourceIpSecPolicy() *schema.Resource {
 				Description: "IPSec Policy Tunnel Flag.",
 			},
 			"source_address": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Policy Source Address",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateIpAddressOrPrefix,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "IPSec Policy Source Address",
 			},
 			"source_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     0,
-				Description: "IPSec Policy Source Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validatePort,
+				Description:      "IPSec Policy Source Port.",
 			},
 			"destination_address": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Policy Destination Address.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateIpAddressOrPrefix,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "IPSec Policy Destination Address.",
 			},
 			"destination_port": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     0,
-				Description: "IPSec Policy Destination Port.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validatePort,
+				Description:      "IPSec Policy Destination Port.",
 			},
 			"protocol": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "all",
-				Description: "IPSec Policy Protocol.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "all",
+				ValidateDiagFunc: validateIpsecPolicyProtocol,
+				Description:      "IPSec Policy Protocol.",
 			},
 			"template": {
 				Type:        schema.TypeBool,
@@ -93,28 +102,32 @@ func resourceIpSecPolicy() *schema.Resource {
 				Description: "IPSec Policy is Template.",
 			},
 			"action": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "encrypt",
-				Description: "IPSec Policy Action.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "encrypt",
+				ValidateDiagFunc: validateEnum("discard", "encrypt", "none"),
+				Description:      "IPSec Policy Action.",
 			},
 			"level": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "require",
-				Description: "IPSec Policy Level.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "require",
+				ValidateDiagFunc: validateEnum("require", "unique", "use"),
+				Description:      "IPSec Policy Level.",
 			},
 			"ipsec_protocol": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "esp",
-				Description: "IPSec Policy IPSec Protocol.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "esp",
+				ValidateDiagFunc: validateEnum("ah", "esp"),
+				Description:      "IPSec Policy IPSec Protocol.",
 			},
 			"proposal": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Policy Proposal.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateOptionalName,
+				Description:      "IPSec Policy Proposal.",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -172,7 +185,7 @@ func readIpSecPolicy(ctx context.Context, d *schema.ResourceData, m interface{})
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert IPSec Policy to Resource Data and put it in Resource Pointer
@@ -228,7 +241,7 @@ func deleteIpSecPolicy(ctx context.Context, d *schema.ResourceData, m interface{
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
@@ -35,7 +35,7 @@ func resourceIpSecPolicyGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/ipsec/policy/group", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,10 +45,11 @@ func resourceIpSecPolicyGroup() *schema.Resource {
 				Computed: true,
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Policy Group Name.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "IPSec Policy Group Name.",
 			},
 		},
 	}
@@ -100,7 +101,7 @@ func readIpSecPolicyGroup(ctx context.Context, d *schema.ResourceData, m interfa
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert IPSec Policy Group to Resource Data and put it in Resource Pointer
@@ -156,7 +157,7 @@ func deleteIpSecPolicyGroup(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
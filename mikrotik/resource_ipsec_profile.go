@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceIpSecProfile() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/ipsec/profile", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,24 +46,28 @@ func resourceIpSecProfile() *schema.Resource {
 				Computed: true,
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Profile Name.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "IPSec Profile Name.",
 			},
 			"dh_group": {
 				Type:     schema.TypeString,
 				Optional: true,
 
 				// Possible : ecp256,ecp384,ecp521,ec2n185,ec2n155,modp8192,modp6144,modp4096,modp3072,modp2048,modp1536,modp1024,modp768
-				Default:     "modp2048,modp3072,modp1536",
-				Description: "IPSec Profile Diffie-Hellman Group.",
+				Default:          "modp2048,modp3072,modp1536",
+				ValidateDiagFunc: validateEnumList("ec2n155", "ec2n185", "ecp256", "ecp384", "ecp521", "modp768", "modp1024", "modp1536", "modp2048", "modp3072", "modp4096", "modp6144", "modp8192"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.CommaList),
+				Description:      "IPSec Profile Diffie-Hellman Group.",
 			},
 			"dpd_interval": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "2m",
-				Description: "IPSec Profile DPD Interval.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "2m",
+				ValidateDiagFunc: validateDuration("disable-dpd"),
+				Description:      "IPSec Profile DPD Interval.",
 			},
 			"dpd_max_failure": {
 				Type:        schema.TypeInt,
@@ -75,22 +80,26 @@ func resourceIpSecProfile() *schema.Resource {
 				Optional: true,
 
 				// Possible : aes-256,camellia-256,aes-192,camellia-192,aes-128,camellia-128,3des,blowfish,des
-				Default:     "aes-256,aes-128",
-				Description: "IPSec Profile Encryption Algorithms.",
+				Default:          "aes-256,aes-128",
+				ValidateDiagFunc: validateEnumList("3des", "aes-128", "aes-192", "aes-256", "blowfish", "camellia-128", "camellia-192", "camellia-256", "des"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.CommaList),
+				Description:      "IPSec Profile Encryption Algorithms.",
 			},
 			"hash_algorithm": {
 				Type:     schema.TypeString,
 				Optional: true,
 
 				// Possible : sha1,sha256,sha384,md5
-				Default:     "sha1",
-				Description: "IPSec Profile Hash Algorithm.",
+				Default:          "sha1",
+				ValidateDiagFunc: validateEnum("md5", "sha1", "sha256", "sha512"),
+				Description:      "IPSec Profile Hash Algorithm.",
 			},
 			"lifetime": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "1h30m",
-				Description: "IPSec Profile Lifetime",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "1h30m",
+				ValidateDiagFunc: validateDuration(),
+				Description:      "IPSec Profile Lifetime",
 			},
 			"nat_traversal": {
 				Type:        schema.TypeBool,
@@ -99,10 +108,11 @@ func resourceIpSecProfile() *schema.Resource {
 				Description: "IPSec Profile NAT Transversal",
 			},
 			"proposal_check": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "obey",
-				Description: "IPSec Profile Lifetime",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "obey",
+				ValidateDiagFunc: validateEnum("claim", "exact", "obey", "strict"),
+				Description:      "IPSec Profile Lifetime",
 			},
 		},
 	}
@@ -154,7 +164,7 @@ func readIpSecProfile(ctx context.Context, d *schema.ResourceData, m interface{}
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert IPSec Profile to Resource Data and put it in Resource Pointer
@@ -210,7 +220,7 @@ func deleteIpSecProfile(ctx context.Context, d *schema.ResourceData, m interface
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
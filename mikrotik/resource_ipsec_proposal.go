@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceIpSecProposal() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/ipsec/proposal", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,36 +46,43 @@ func resourceIpSecProposal() *schema.Resource {
 				Computed: true,
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "IPSec Proposal Name.",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "IPSec Proposal Name.",
 			},
 			"auth_algorithms": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "sha512,sha256,sha1,md5",
-				Description: "IPSec Proposal Authentication Algorithms List.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "sha512,sha256,sha1,md5",
+				ValidateDiagFunc: validateEnumList("md5", "null", "sha1", "sha256", "sha512"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.CommaList),
+				Description:      "IPSec Proposal Authentication Algorithms List.",
 			},
 			"enc_algorithms": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "aes-256-cbc,aes-256-ctr,aes-256-gcm,camellia-256,aes-192-cbc,aes-192-ctr,aes-192-gcm,camellia-192,aes-128-cbc,aes-128-ctr,aes-128-gcm,camellia-128,3des,blowfish,twofish,des",
-				Description: "IPSec Proposal Encryption Algorithms List.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "aes-256-cbc,aes-256-ctr,aes-256-gcm,camellia-256,aes-192-cbc,aes-192-ctr,aes-192-gcm,camellia-192,aes-128-cbc,aes-128-ctr,aes-128-gcm,camellia-128,3des,blowfish,twofish,des",
+				ValidateDiagFunc: validateEnumList("3des", "aes-128-cbc", "aes-128-ctr", "aes-128-gcm", "aes-192-cbc", "aes-192-ctr", "aes-192-gcm", "aes-256-cbc", "aes-256-ctr", "aes-256-gcm", "blowfish", "camellia-128", "camellia-192", "camellia-256", "des", "null", "twofish"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.CommaList),
+				Description:      "IPSec Proposal Encryption Algorithms List.",
 			},
 			"lifetime": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "30m",
-				Description: "IPSec Proposal Lifetime.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "30m",
+				ValidateDiagFunc: validateDuration(),
+				Description:      "IPSec Proposal Lifetime.",
 			},
 			"pfs_group": {
 				Type:     schema.TypeString,
 				Optional: true,
 
 				// Possible : none, modp1024, modp1536, modp2048, modp3072, modp4096, modp6144, modp8192, ecp521, ecp384, ecp256
-				Default:     "modp1024",
-				Description: "IPSec Proposal PSF Group.",
+				Default:          "modp1024",
+				ValidateDiagFunc: validateEnum("none", "ec2n155", "ec2n185", "ecp256", "ecp384", "ecp521", "modp768", "modp1024", "modp1536", "modp2048", "modp3072", "modp4096", "modp6144", "modp8192"),
+				Description:      "IPSec Proposal PSF Group.",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -132,7 +140,7 @@ func readIpSecProposal(ctx context.Context, d *schema.ResourceData, m interface{
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert IPSec Proposal to Resource Data and put it in Resource Pointer
@@ -188,7 +196,7 @@ func deleteIpSecProposal(ctx context.Context, d *schema.ResourceData, m interfac
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceIpv6Address() *schema.Resource {
@@ -17,14 +18,16 @@ func resourceIpv6Address() *schema.Resource {
 		UpdateContext: resourceIpv6AddressUpdate,
 		DeleteContext: resourceIpv6AddressDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ipv6/address", ".id"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The IPv6 address and prefix length of the interface using slash notation.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpv6AddressOrPrefix,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "The IPv6 address and prefix length of the interface using slash notation.",
 			},
 			"advertise": {
 				Type:        schema.TypeBool,
@@ -48,14 +51,16 @@ func resourceIpv6Address() *schema.Resource {
 				Description: "Whether to calculate EUI-64 address and use it as last 64 bits of the IPv6 address.",
 			},
 			"from_pool": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Name of the pool from which prefix will be taken to construct IPv6 address taking last part of the address from address property.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOptionalName,
+				Description:      "Name of the pool from which prefix will be taken to construct IPv6 address taking last part of the address from address property.",
 			},
 			"interface": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The interface on which the IPv6 address is assigned.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "The interface on which the IPv6 address is assigned.",
 			},
 			"no_dad": {
 				Type:        schema.TypeBool,
@@ -85,16 +90,8 @@ func resourceIpv6AddressRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	ipv6addr, err := c.FindIpv6Address(d.Id())
 
-	// Clear the state if the error represents that the resource no longer exists
-	_, resourceMissing := err.(*client.NotFound)
-	if resourceMissing && err != nil {
-		d.SetId("")
-		return nil
-	}
-
-	// Make sure all other errors are propagated
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return v6addrToData(ipv6addr, d)
@@ -121,7 +118,7 @@ func resourceIpv6AddressDelete(ctx context.Context, d *schema.ResourceData, m in
 	err := c.DeleteIpv6Address(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	d.SetId("")
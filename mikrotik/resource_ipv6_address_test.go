@@ -12,7 +12,7 @@ import (
 )
 
 func TestAccMikrotikResourceIpv6Address_create(t *testing.T) {
-	if client.IsLegacyBgpSupported() {
+	if client.IsLegacyBgpSupported(t) {
 		t.Skip()
 	}
 
@@ -41,7 +41,7 @@ func TestAccMikrotikResourceIpv6Address_create(t *testing.T) {
 }
 
 func TestAccMikrotikResourceIpv6Address_updateAddr(t *testing.T) {
-	if client.IsLegacyBgpSupported() {
+	if client.IsLegacyBgpSupported(t) {
 		t.Skip()
 	}
 
@@ -0,0 +1,192 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+func resourceIpv6Nd() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the neighbor discovery settings of an interface, i.e. the router advertisements SLAAC clients configure themselves from. The default entry of `all` interfaces can only be imported, and is left as is on destroy.",
+
+		CreateContext: resourceIpv6NdCreate,
+		ReadContext:   resourceIpv6NdRead,
+		UpdateContext: resourceIpv6NdUpdate,
+		DeleteContext: resourceIpv6NdDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ipv6/nd", "interface"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"interface": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface the router advertisements are sent on, `all` for the default entry.",
+			},
+			"ra_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "3m20s-10m",
+				ValidateDiagFunc: validateString(checkDurationRange),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.DurationRange),
+				Description:      "Range of the interval between unsolicited advertisements.",
+			},
+			"ra_delay": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "3s",
+				ValidateDiagFunc: validateDuration(),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Shortest time between two advertisements.",
+			},
+			"ra_lifetime": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "30m",
+				ValidateDiagFunc: validateDuration("none"),
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.Duration),
+				Description:      "Time the router is used as default router by the clients, `none` not to be one.",
+			},
+			"hop_limit": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "unspecified",
+				ValidateDiagFunc: validateNumberOrKeyword(1, 255, "unspecified"),
+				Description:      "Hop limit advertised for the packets the clients send.",
+			},
+			"mtu": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "unspecified",
+				ValidateDiagFunc: validateNumberOrKeyword(1280, 65535, "unspecified"),
+				Description:      "MTU advertised.",
+			},
+			"managed_address_configuration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Managed flag: whether clients take their address from a DHCPv6 server.",
+			},
+			"other_configuration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Other configuration flag: whether clients take other settings (e.g. DNS) from a DHCPv6 server.",
+			},
+			"advertise_mac_address": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the MAC address of the interface is advertised.",
+			},
+			"advertise_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the DNS servers of the router are advertised (RDNSS).",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether router advertisements are disabled on the interface.",
+			},
+		},
+	}
+}
+
+func resourceIpv6NdCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddIpv6Nd(dataToIpv6Nd(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceIpv6NdRead(ctx, d, m)
+}
+
+func resourceIpv6NdRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindIpv6Nd(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return ipv6NdToData(record, d)
+}
+
+func resourceIpv6NdUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateIpv6Nd(dataToIpv6Nd(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIpv6NdRead(ctx, d, m)
+}
+
+func resourceIpv6NdDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+
+	// The default entry cannot be removed, it is left as is
+	if record, err := c.FindIpv6Nd(d.Id()); err == nil && record.Default {
+		return nil
+	}
+
+	if err := c.DeleteIpv6Nd(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+func dataToIpv6Nd(d *schema.ResourceData) *client.Ipv6Nd {
+	return &client.Ipv6Nd{
+		Id:                          d.Id(),
+		Interface:                   d.Get("interface").(string),
+		RaInterval:                  d.Get("ra_interval").(string),
+		RaDelay:                     d.Get("ra_delay").(string),
+		RaLifetime:                  d.Get("ra_lifetime").(string),
+		HopLimit:                    d.Get("hop_limit").(string),
+		Mtu:                         d.Get("mtu").(string),
+		ManagedAddressConfiguration: d.Get("managed_address_configuration").(bool),
+		OtherConfiguration:          d.Get("other_configuration").(bool),
+		AdvertiseMacAddress:         d.Get("advertise_mac_address").(bool),
+		AdvertiseDns:                d.Get("advertise_dns").(bool),
+		Disabled:                    d.Get("disabled").(bool),
+	}
+}
+
+func ipv6NdToData(r *client.Ipv6Nd, d *schema.ResourceData) diag.Diagnostics {
+	values := map[string]interface{}{
+		"interface":                     r.Interface,
+		"ra_interval":                   r.RaInterval,
+		"ra_delay":                      r.RaDelay,
+		"ra_lifetime":                   r.RaLifetime,
+		"hop_limit":                     r.HopLimit,
+		"mtu":                           r.Mtu,
+		"managed_address_configuration": r.ManagedAddressConfiguration,
+		"other_configuration":           r.OtherConfiguration,
+		"advertise_mac_address":         r.AdvertiseMacAddress,
+		"advertise_dns":                 r.AdvertiseDns,
+		"disabled":                      r.Disabled,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
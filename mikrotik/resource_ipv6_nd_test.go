@@ -0,0 +1,78 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikIpv6Nd_createAndUpdate(t *testing.T) {
+	if client.IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	name := acctest.RandomWithPrefix("tf-acc-ipv6-nd")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikIpv6NdDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6Nd(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_ipv6_nd.lan", "interface", name),
+					resource.TestCheckResourceAttr("mikrotik_ipv6_nd.lan", "advertise_dns", "true"),
+					resource.TestCheckResourceAttr("mikrotik_ipv6_nd.lan", "managed_address_configuration", "false"),
+				),
+			},
+			{
+				Config: testAccIpv6Nd(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_ipv6_nd.lan", "managed_address_configuration", "true"),
+					resource.TestCheckResourceAttr("mikrotik_ipv6_nd.lan", "other_configuration", "true"),
+				),
+			},
+			{
+				ResourceName:      "mikrotik_ipv6_nd.lan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIpv6Nd(name string, managed bool) string {
+	return fmt.Sprintf(`
+resource "mikrotik_bridge_interface" "lan" {
+  name = %[1]q
+}
+
+resource "mikrotik_ipv6_nd" "lan" {
+  interface                     = mikrotik_bridge_interface.lan.name
+  ra_interval                   = "1m-3m"
+  advertise_dns                 = true
+  managed_address_configuration = %[2]t
+  other_configuration           = %[2]t
+}
+`, name, managed)
+}
+
+func testAccCheckMikrotikIpv6NdDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_ipv6_nd" {
+			continue
+		}
+
+		_, err := c.FindIpv6Nd(rs.Primary.ID)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
+)
+
+func resourceIpv6Pool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages an IPv6 pool, the prefixes DHCPv6 servers delegate and `from_pool` addresses are taken from.",
+
+		CreateContext: resourceIpv6PoolCreate,
+		ReadContext:   resourceIpv6PoolRead,
+		UpdateContext: resourceIpv6PoolUpdate,
+		DeleteContext: resourceIpv6PoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByKeyProperty("/ipv6/pool", "name"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the pool.",
+			},
+			"prefix": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpv6Prefix,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.IpAddress),
+				Description:      "Prefix the pool hands out prefixes of (e.g. `2001:db8::/48`).",
+			},
+			"prefix_length": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 128)),
+				Description:      "Length of the prefixes handed out, at least the length of `prefix` (e.g. `64`).",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment of the pool.",
+			},
+		},
+	}
+}
+
+func resourceIpv6PoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.AddIpv6Pool(dataToIpv6Pool(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(record.Id)
+
+	return resourceIpv6PoolRead(ctx, d, m)
+}
+
+func resourceIpv6PoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	record, err := c.FindIpv6Pool(d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	return ipv6PoolToData(record, d)
+}
+
+func resourceIpv6PoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	_, err := c.UpdateIpv6Pool(dataToIpv6Pool(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIpv6PoolRead(ctx, d, m)
+}
+
+func resourceIpv6PoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Mikrotik)
+	if err := c.DeleteIpv6Pool(d.Id()); err != nil {
+		return deleteError(d, err)
+	}
+
+	return nil
+}
+
+func dataToIpv6Pool(d *schema.ResourceData) *client.Ipv6Pool {
+	return &client.Ipv6Pool{
+		Id:           d.Id(),
+		Name:         d.Get("name").(string),
+		Prefix:       d.Get("prefix").(string),
+		PrefixLength: d.Get("prefix_length").(int),
+		Comment:      d.Get("comment").(string),
+	}
+}
+
+func ipv6PoolToData(r *client.Ipv6Pool, d *schema.ResourceData) diag.Diagnostics {
+	values := map[string]interface{}{
+		"name":          r.Name,
+		"prefix":        r.Prefix,
+		"prefix_length": r.PrefixLength,
+		"comment":       r.Comment,
+	}
+
+	d.SetId(r.Id)
+
+	var diags diag.Diagnostics
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("failed to set %s: %v", key, err)...)
+		}
+	}
+
+	return diags
+}
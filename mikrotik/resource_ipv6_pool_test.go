@@ -0,0 +1,70 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestAccMikrotikIpv6Pool_createAndUpdate(t *testing.T) {
+	if client.IsLegacyBgpSupported(t) {
+		t.Skip()
+	}
+
+	name := acctest.RandomWithPrefix("tf-acc-ipv6-pool")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikIpv6PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6Pool(name, 64),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_ipv6_pool.lan", "prefix", "2001:db8:300::/48"),
+					resource.TestCheckResourceAttr("mikrotik_ipv6_pool.lan", "prefix_length", "64"),
+				),
+			},
+			{
+				Config: testAccIpv6Pool(name, 56),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mikrotik_ipv6_pool.lan", "prefix_length", "56"),
+				),
+			},
+			{
+				ResourceName:      "mikrotik_ipv6_pool.lan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIpv6Pool(name string, prefixLength int) string {
+	return fmt.Sprintf(`
+resource "mikrotik_ipv6_pool" "lan" {
+  name          = %q
+  prefix        = "2001:db8:300::/48"
+  prefix_length = %d
+}
+`, name, prefixLength)
+}
+
+func testAccCheckMikrotikIpv6PoolDestroy(s *terraform.State) error {
+	c := client.NewClient(client.GetConfigFromEnv())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mikrotik_ipv6_pool" {
+			continue
+		}
+
+		_, err := c.FindIpv6Pool(rs.Primary.ID)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("%s (%s) still exists: %v", rs.Type, rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
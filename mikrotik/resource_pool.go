@@ -16,20 +16,25 @@ func resourcePool() *schema.Resource {
 		ReadContext:   resourcePoolRead,
 		UpdateContext: resourcePoolUpdate,
 		DeleteContext: resourcePoolDelete,
+		CustomizeDiff: addressPlanCustomizeDiff(checkPoolPlan, "name", "ranges"),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaults(importStateByNaturalKey("/ip/pool", ".id"), map[string]interface{}{
+				"conflict_check": conflictCheckOff,
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of IP pool.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "The name of IP pool.",
 			},
 			"ranges": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The IP range(s) of the pool. Multiple ranges can be specified, separated by commas: `172.16.0.6-172.16.0.12,172.16.0.50-172.16.0.60`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIpv4Ranges,
+				Description:      "The IP range(s) of the pool. Multiple ranges can be specified, separated by commas: `172.16.0.6-172.16.0.12,172.16.0.50-172.16.0.60`.",
 			},
 			"next_pool": {
 				Type:     schema.TypeString,
@@ -45,13 +50,15 @@ func resourcePool() *schema.Resource {
 
 					return v
 				},
-				Description: "The IP pool to pick next address from if current is exhausted.",
+				ValidateDiagFunc: validateOptionalName,
+				Description:      "The IP pool to pick next address from if current is exhausted.",
 			},
 			"comment": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The comment of the IP Pool to be created.",
 			},
+			"conflict_check": conflictCheckSchema(),
 		},
 	}
 }
@@ -60,13 +67,17 @@ func resourcePoolCreate(ctx context.Context, d *schema.ResourceData, m interface
 	p := preparePool(d)
 
 	c := m.(*client.Mikrotik)
+	diags := addressPlanApplyCheck(c, d, checkPoolPlan)
+	if diags.HasError() {
+		return diags
+	}
 
 	pool, err := c.AddPool(p)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return poolToData(pool, d)
+	return append(diags, poolToData(pool, d)...)
 }
 
 func resourcePoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -74,12 +85,8 @@ func resourcePoolRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	pool, err := c.FindPool(d.Id())
 
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return poolToData(pool, d)
@@ -90,6 +97,10 @@ func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 	p := preparePool(d)
 	p.Id = d.Id()
+	diags := addressPlanApplyCheck(c, d, checkPoolPlan)
+	if diags.HasError() {
+		return diags
+	}
 
 	pool, err := c.UpdatePool(p)
 
@@ -97,7 +108,7 @@ func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
-	return poolToData(pool, d)
+	return append(diags, poolToData(pool, d)...)
 }
 
 func resourcePoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -106,7 +117,7 @@ func resourcePoolDelete(ctx context.Context, d *schema.ResourceData, m interface
 	err := c.DeletePool(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	d.SetId("")
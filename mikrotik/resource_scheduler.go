@@ -5,11 +5,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
 )
 
 func resourceScheduler() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Creates a Mikrotik scheduler.",
 
 		CreateContext: resourceSchedulerCreate,
@@ -17,19 +18,23 @@ func resourceScheduler() *schema.Resource {
 		UpdateContext: resourceSchedulerUpdate,
 		DeleteContext: resourceSchedulerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/system/scheduler", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the task.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the task.",
 			},
 			"on_event": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the script to execute. It must exist `/system script`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Name of the script to execute. It must exist `/system script`.",
 			},
 			"start_date": {
 				Type:        schema.TypeString,
@@ -42,13 +47,20 @@ func resourceScheduler() *schema.Resource {
 				Description: "Time of the first script execution.",
 			},
 			"interval": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     0,
-				Description: "Interval between two script executions, if time interval is set to zero, the script is only executed at its start time, otherwise it is executed repeatedly at the time interval is specified.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Interval between two script executions, if time interval is set to zero, the script is only executed at its start time, otherwise it is executed repeatedly at the time interval is specified.",
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceSchedulerV0(), "/system/scheduler", "name"),
+	}
+
+	return resource
 }
 
 func resourceSchedulerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -68,12 +80,8 @@ func resourceSchedulerRead(ctx context.Context, d *schema.ResourceData, m interf
 	c := m.(*client.Mikrotik)
 
 	scheduler, err := c.FindScheduler(d.Id())
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return schedulerToData(scheduler, d)
@@ -94,14 +102,12 @@ func resourceSchedulerUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceSchedulerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	name := d.Id()
-
 	c := m.(*client.Mikrotik)
 
-	err := c.DeleteScheduler(name)
+	err := c.DeleteScheduler(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 	d.SetId("")
 	return nil
@@ -120,7 +126,7 @@ func schedulerToData(s *client.Scheduler, d *schema.ResourceData) diag.Diagnosti
 		"interval":   s.Interval,
 	}
 
-	d.SetId(s.Name)
+	d.SetId(s.Id)
 
 	var diags diag.Diagnostics
 
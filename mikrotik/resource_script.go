@@ -6,10 +6,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 func resourceScript() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Creates a MikroTik script.",
 
 		CreateContext: resourceScriptCreate,
@@ -17,14 +18,17 @@ func resourceScript() *schema.Resource {
 		UpdateContext: resourceScriptUpdate,
 		DeleteContext: resourceScriptDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/system/script", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of script.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "The name of script.",
 			},
 			"owner": {
 				Type:        schema.TypeString,
@@ -40,7 +44,8 @@ func resourceScript() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateEnum("ftp", "reboot", "read", "write", "policy", "test", "password", "sniff", "sensitive", "romon"),
 				},
 				Description: "What permissions the script has. This must be one of the following: ftp, reboot, read, write, policy, test, password, sniff, sensitive, romon.",
 			},
@@ -52,6 +57,12 @@ func resourceScript() *schema.Resource {
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceScriptV0(), "/system/script", "name"),
+	}
+
+	return resource
 }
 
 func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -82,15 +93,23 @@ func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func scriptToData(s *client.Script, d *schema.ResourceData) diag.Diagnostics {
+	// RouterOS reorders the policy list, keep the known order when the content matches
+	known := []string{}
+	for _, p := range d.Get("policy").([]interface{}) {
+		if str, ok := p.(string); ok {
+			known = append(known, str)
+		}
+	}
+
 	values := map[string]interface{}{
 		"name":                     s.Name,
 		"owner":                    s.Owner,
 		"source":                   s.Source,
-		"policy":                   s.Policy(),
+		"policy":                   normalize.OrderLike(known, s.Policy()),
 		"dont_require_permissions": s.DontRequirePermissions,
 	}
 
-	d.SetId(s.Name)
+	d.SetId(s.Id)
 
 	var diags diag.Diagnostics
 
@@ -108,12 +127,8 @@ func resourceScriptRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	script, err := c.FindScript(d.Id())
 
-	if _, ok := err.(*client.NotFound); ok {
-		d.SetId("")
-		return nil
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return scriptToData(script, d)
@@ -134,7 +149,7 @@ func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	c := m.(*client.Mikrotik)
 
-	script, err := c.UpdateScript(name, owner, source, policies, dontReqPerms)
+	script, err := c.UpdateScript(d.Id(), name, owner, source, policies, dontReqPerms)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -142,14 +157,12 @@ func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	return scriptToData(script, d)
 }
 func resourceScriptDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	name := d.Id()
-
 	c := m.(*client.Mikrotik)
 
-	err := c.DeleteScript(name)
+	err := c.DeleteScript(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 	d.SetId("")
 	return nil
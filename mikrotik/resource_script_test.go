@@ -1,7 +1,9 @@
 package mikrotik
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -165,6 +167,70 @@ func TestAccMikrotikScript_import(t *testing.T) {
 	})
 }
 
+func TestAccMikrotikScript_rename(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-rename")
+	var id string
+
+	resourceName := "mikrotik_script.bar"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMikrotikScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScriptRecord(name, defaultOwner, defaultSource, defaultPolicies),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccScriptExists(resourceName),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					}),
+			},
+			{
+				Config: testAccScriptRecord(name+"-renamed", defaultOwner, defaultSource, defaultPolicies),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccScriptExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-renamed"),
+					func(s *terraform.State) error {
+						if renamedId := s.RootModule().Resources[resourceName].Primary.ID; renamedId != id {
+							return fmt.Errorf("expected the id to stay %s after the rename, got %s", id, renamedId)
+						}
+						return nil
+					}),
+			},
+		},
+	})
+}
+
+func TestAccMikrotikScript_upgradeNameKeyedState(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	testAccPreCheck(t)
+
+	name := acctest.RandomWithPrefix("tf-acc-upgrade")
+	c := client.NewClient(client.GetConfigFromEnv())
+
+	script, err := c.CreateScript(name, defaultOwner, defaultSource, defaultPolicies, false)
+	if err != nil {
+		t.Fatalf("Error creating the script: %v", err)
+	}
+	defer func() {
+		_ = c.DeleteScript(script.Id)
+	}()
+
+	r := resourceScript()
+	rawState := map[string]interface{}{"id": name, "name": name}
+	upgraded, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, c)
+	if err != nil {
+		t.Fatalf("Error upgrading the state: %v", err)
+	}
+
+	if upgraded["id"] != script.Id {
+		t.Errorf("expected the upgraded id to be %s, got %v", script.Id, upgraded["id"])
+	}
+}
+
 func testAccScriptRecord(name, owner, source string, policies []string) string {
 	return fmt.Sprintf(`
 resource "mikrotik_script" "bar" {
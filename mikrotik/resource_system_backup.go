@@ -0,0 +1,170 @@
+package mikrotik
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Define System Backup Resource: a Backup File saved when the Resource is created, and saved again
+ * every time one of its Triggers changes
+ */
+func resourceSystemBackup() *schema.Resource {
+
+	// Build and Return Resource
+	return &schema.Resource{
+
+		// Resource Description
+		Description: "Saves a System Backup file within MikroTik device. The backup is saved again whenever `triggers` change.",
+
+		// Create Resource Context Method CallBack
+		CreateContext: createSystemBackup,
+
+		// Read Resource Context Method CallBack
+		ReadContext: readSystemBackup,
+
+		// Delete Resource Context Method CallBack
+		DeleteContext: deleteSystemBackup,
+
+		// Define Resource State Context Importer
+		Importer: &schema.ResourceImporter{
+
+			// Define State Context (the ID is the Backup Name)
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Define Resource Schema
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "System Backup Name (the file is named `<name>.backup`).",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "System Backup Password.",
+			},
+			"encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateEnum("aes-sha256", "rc4"),
+				Description:      "System Backup Encryption Algorithm (aes-sha256, rc4).",
+			},
+			"dont_encrypt": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Save the System Backup without encryption.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that cause the System Backup to be saved again when they change.",
+			},
+			"size": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "System Backup File Size.",
+			},
+			"creation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "System Backup File Creation Time.",
+			},
+		},
+	}
+}
+
+/**
+ * Function used to Create System Backup
+ */
+func createSystemBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Get System Backup Client
+	c := m.(*client.Mikrotik)
+
+	// Save System Backup
+	_, err := c.SaveSystemBackup(&client.SystemBackup{
+		Name:        d.Get("name").(string),
+		Password:    d.Get("password").(string),
+		Encryption:  d.Get("encryption").(string),
+		DontEncrypt: d.Get("dont_encrypt").(bool),
+	})
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return diag.FromErr(err)
+	}
+
+	// Set ID
+	d.SetId(d.Get("name").(string))
+
+	// Reload System Backup
+	return readSystemBackup(ctx, d, m)
+}
+
+/**
+ * Function used to Read System Backup
+ */
+func readSystemBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Define Diagnostic variable
+	var diags diag.Diagnostics
+
+	// Get System Backup Client
+	c := m.(*client.Mikrotik)
+
+	// Find Backup File (a removed File is saved again)
+	file, err := c.FindFile(d.Id() + ".backup")
+
+	// If there is Error
+	if err != nil {
+
+		// Return Error
+		return readError(d, err)
+	}
+
+	// Set Values
+	d.Set("name", d.Id())
+	d.Set("size", file.Size)
+	d.Set("creation_time", file.CreationTime)
+
+	// Return Diagnistic
+	return diags
+}
+
+/**
+ * Function used to Delete System Backup
+ */
+func deleteSystemBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	// Define Diagnostic variable
+	var diags diag.Diagnostics
+
+	// Get System Backup Client
+	c := m.(*client.Mikrotik)
+
+	// Remove Backup File
+	if err := c.DeleteFile(d.Id() + ".backup"); err != nil {
+
+		// Return Error
+		return deleteError(d, err)
+	}
+
+	// Return Diagnistic
+	return diags
+}
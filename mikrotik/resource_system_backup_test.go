@@ -0,0 +1,107 @@
+package mikrotik
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * System Backup Resource Create and Retrigger Test
+ */
+func TestSystemBackup_CreateAndRetrigger(t *testing.T) {
+
+	// Initialize Resource Name
+	resourceName := "mikrotik_system_backup.testacc"
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Check destroy Callback
+		CheckDestroy: testAccCheckSystemBackupDestroy,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Resource
+				Config: testAccSystemBackup("tf-acc-backup", "1"),
+
+				// Check Test Resource
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tf-acc-backup"),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+				),
+			},
+			{
+				// Configure Test Resource with changed Triggers (the Backup is saved again)
+				Config: testAccSystemBackup("tf-acc-backup", "2"),
+
+				// Check Test Resource
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+				),
+			},
+		},
+	})
+}
+
+/**
+ * Function used to Test if Terraform Resource is Destroyed
+ */
+func testAccCheckSystemBackupDestroy(s *terraform.State) error {
+
+	// Build Client
+	c := client.NewClient(client.GetConfigFromEnv())
+
+	// Iterate over Resources
+	for _, rs := range s.RootModule().Resources {
+
+		// If Resource is not System Backup
+		if rs.Type != "mikrotik_system_backup" {
+
+			// Continue Iteration
+			continue
+		}
+
+		// Find Backup File
+		_, err := c.FindFile(rs.Primary.ID + ".backup")
+
+		// If File still exists
+		if !client.IsNotFound(err) {
+
+			// Return Formatted Error
+			return fmt.Errorf("remote backup file (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	// Return nil
+	return nil
+}
+
+/**
+ * Function used to build Terraform Resource Configuration
+ */
+func testAccSystemBackup(name, revision string) string {
+
+	// Return Resource Configuration
+	return fmt.Sprintf(`
+resource "mikrotik_system_backup" "testacc" {
+	name         = %q
+	dont_encrypt = true
+	triggers = {
+		revision = %q
+	}
+}
+`, name, revision)
+}
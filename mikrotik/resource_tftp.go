@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+	"github.com/kube-cloud/terraform-provider-mikrotik/mikrotik/internal/normalize"
 )
 
 /**
@@ -35,7 +36,7 @@ func resourceTftp() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 
 			// Define State Context
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByNaturalKey("/ip/tftp", ".id"),
 		},
 
 		// Define Resource Schema
@@ -45,10 +46,12 @@ func resourceTftp() *schema.Resource {
 				Computed: true,
 			},
 			"ip_addresses": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Description: "TFTP Server Addresses List (comma separated).",
+				Type:             schema.TypeString,
+				Optional:         false,
+				Required:         true,
+				ValidateDiagFunc: validateIpAddressOrPrefixList,
+				DiffSuppressFunc: normalize.SuppressEquivalent(normalize.CommaList),
+				Description:      "TFTP Server Addresses List (comma separated).",
 			},
 			"request_file_name": {
 				Type:        schema.TypeString,
@@ -135,7 +138,7 @@ func readTftp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.D
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	// Convert TFTP to Resource Data and put it in Resource Pointer
@@ -191,7 +194,7 @@ func deleteTftp(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	if err != nil {
 
 		// Return Error
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	// Return Diagnistic
@@ -97,6 +97,46 @@ func TestTftp_Create(t *testing.T) {
 	})
 }
 
+/**
+ * TFTP Resource Deleted out of band Test
+ */
+func TestTftp_Disappears(t *testing.T) {
+
+	// Initialize Resource Name
+	resourceName := "mikrotik_tftp.testacc"
+
+	// Initialize Test
+	resource.Test(t, resource.TestCase{
+
+		// Initialize Test Case Precheck Callback
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Initialize Test Case Provider Factory Callback
+		ProviderFactories: testAccProviderFactories,
+
+		// Initialize Check destroy Callback
+		CheckDestroy: testAccCheckTftpDestroy,
+
+		// Initialize Test Steps
+		Steps: []resource.TestStep{
+			{
+				// Configure Test Resource
+				Config: testAccTftp("10.10.10.1", "pxelinux.0", "pxelinux.0", true, true, false, "Test TFTP"),
+
+				// Delete the TFTP Entry behind Terraform's back (twice, the second delete finds nothing)
+				Check: resource.ComposeTestCheckFunc(
+					testAccTftpExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceTftp(), resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceTftp(), resourceName),
+				),
+
+				// The Refresh drops the TFTP Entry, so the Plan recreates it
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 /**
  * Function used to Test if Terraform Resource Exists
  */
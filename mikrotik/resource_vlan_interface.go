@@ -9,7 +9,7 @@ import (
 )
 
 func resourceVlanInterface() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description: "Manages Virtual Local Area Network (VLAN) interfaces.",
 
 		CreateContext: resourceVlanInterfaceCreate,
@@ -18,26 +18,31 @@ func resourceVlanInterface() *schema.Resource {
 		DeleteContext: resourceVlanInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByKeyProperty("/interface/vlan", "name"),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"interface": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "*0",
-				Description: "Name of physical interface on top of which VLAN will work.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "*0",
+				ValidateDiagFunc: validateName,
+				Description:      "Name of physical interface on top of which VLAN will work.",
 			},
 			"mtu": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     1500,
-				Description: "Layer3 Maximum transmission unit.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1500,
+				ValidateDiagFunc: validateMtu,
+				Description:      "Layer3 Maximum transmission unit.",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Interface name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateName,
+				Description:      "Interface name.",
 			},
 			"disabled": {
 				Type:        schema.TypeBool,
@@ -50,9 +55,17 @@ func resourceVlanInterface() *schema.Resource {
 				Description: "802.1ad compatible Service Tag.",
 			},
 			"vlan_id": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Virtual LAN identifier or tag that is used to distinguish VLANs. Must be equal for all computers that belong to the same VLAN.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validateVlanId,
+				Description:      "Virtual LAN identifier or tag that is used to distinguish VLANs. Must be equal for all computers that belong to the same VLAN.",
+			},
+			"arp": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "enabled",
+				ValidateDiagFunc: validateEnum(interfaceArpModes...),
+				Description:      "Address Resolution Protocol mode: `enabled`, `disabled`, `reply-only` (only answers for addresses pinned by static `mikrotik_ip_arp` entries), `proxy-arp` or `local-proxy-arp`.",
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -62,6 +75,12 @@ func resourceVlanInterface() *schema.Resource {
 			},
 		},
 	}
+
+	resource.StateUpgraders = []schema.StateUpgrader{
+		upgradeStateToMikrotikId(resourceVlanInterfaceV0(), "/interface/vlan", "name"),
+	}
+
+	return resource
 }
 
 func resourceVlanInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -71,7 +90,7 @@ func resourceVlanInterfaceCreate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(record.Name)
+	d.SetId(record.Id)
 
 	return resourceVlanInterfaceRead(ctx, d, m)
 }
@@ -80,7 +99,7 @@ func resourceVlanInterfaceRead(ctx context.Context, d *schema.ResourceData, m in
 	c := m.(*client.Mikrotik)
 	record, err := c.FindVlanInterface(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
 	return recordVlanInterfaceToData(record, d)
@@ -99,7 +118,6 @@ func resourceVlanInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(record.Name)
 
 	return nil
 }
@@ -108,7 +126,7 @@ func resourceVlanInterfaceDelete(ctx context.Context, d *schema.ResourceData, m
 	c := m.(*client.Mikrotik)
 	err := c.DeleteVlanInterface(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return deleteError(d, err)
 	}
 
 	return nil
@@ -122,6 +140,7 @@ func dataToVlanInterface(d *schema.ResourceData) *client.VlanInterface {
 		Disabled:      d.Get("disabled").(bool),
 		UseServiceTag: d.Get("use_service_tag").(bool),
 		VlanId:        d.Get("vlan_id").(int),
+		Arp:           d.Get("arp").(string),
 		Comment:       d.Get("comment").(string),
 	}
 }
@@ -153,11 +172,15 @@ func recordVlanInterfaceToData(r *client.VlanInterface, d *schema.ResourceData)
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	if err := d.Set("arp", r.Arp); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	if err := d.Set("comment", r.Comment); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	d.SetId(r.Name)
+	d.SetId(r.Id)
 
 	return diags
 }
@@ -31,6 +31,7 @@ func TestVlanInterface_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "mtu", strconv.Itoa(mtu)),
 					resource.TestCheckResourceAttr(resourceName, "vlan_id", strconv.Itoa(vlanID)),
+					resource.TestCheckResourceAttr(resourceName, "arp", "enabled"),
 				),
 			},
 			{
@@ -47,6 +48,26 @@ func TestVlanInterface_basic(t *testing.T) {
 	})
 }
 
+func TestVlanInterface_disappears(t *testing.T) {
+	resourceName := "mikrotik_vlan_interface.testacc"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVlanInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlanInterface("ether1", 1500, "test-vlan-disappears", false, 21),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVlanInterfaceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceVlanInterface(), resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceVlanInterface(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccVlanInterfaceExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
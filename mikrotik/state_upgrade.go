@@ -0,0 +1,246 @@
+package mikrotik
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+/**
+ * Function used to build the State Upgrader moving Version 0 State, whose ID is the value of a Key
+ * Attribute (e.g. the name), to the MikroTik `.id` of the Menu Item matching the given Attributes.
+ * The State is decoded with the given Version 0 Schema, as stored before the Upgrade
+ */
+func upgradeStateToMikrotikId(v0 *schema.Resource, menu string, attributes ...string) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: 0,
+		Type:    v0.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+
+			// Get Current ID
+			id, _ := rawState["id"].(string)
+
+			// If State is already keyed on the MikroTik ID
+			if id == "" || strings.HasPrefix(id, "*") {
+
+				// Keep State as is
+				return rawState, nil
+			}
+
+			// Build Query Filters from the Key Attributes
+			filters := []client.QueryFilter{}
+			for _, attribute := range attributes {
+				if value, ok := rawState[attribute].(string); ok && value != "" {
+					filters = append(filters, client.QueryFilter{Property: naturalKeyProperty(attribute), Value: value})
+				}
+			}
+
+			// Without Filters, any single Item of the Menu would match
+			if len(filters) == 0 {
+				return nil, fmt.Errorf("cannot upgrade the state of `%s`: none of %s is set", id, strings.Join(attributes, ", "))
+			}
+
+			// Get Mikrotik Client
+			c := m.(*client.Mikrotik)
+
+			// Resolve the MikroTik ID
+			mikrotikId, err := c.ResolveProperty(menu, filters, ".id")
+
+			// If Item is gone (the next read removes it from state)
+			if client.IsNotFound(err) {
+
+				// Keep State as is
+				return rawState, nil
+			}
+
+			// If There is Error
+			if err != nil {
+
+				// Return Error
+				return nil, err
+			}
+
+			// Set MikroTik ID
+			rawState["id"] = mikrotikId
+
+			// Return Upgraded State
+			return rawState, nil
+		},
+	}
+}
+
+// The schemas below are copies of the resources at version 0, before their state was keyed on the
+// MikroTik ID. They only describe the stored state and must not follow later schema changes.
+
+func resourceBgpInstanceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"as":                          {Type: schema.TypeInt, Required: true},
+			"client_to_client_reflection": {Type: schema.TypeBool, Optional: true},
+			"cluster_id":                  {Type: schema.TypeString, Optional: true},
+			"comment":                     {Type: schema.TypeString, Optional: true},
+			"confederation":               {Type: schema.TypeInt, Optional: true},
+			"confederation_peers":         {Type: schema.TypeString, Optional: true},
+			"disabled":                    {Type: schema.TypeBool, Optional: true},
+			"ignore_as_path_len":          {Type: schema.TypeBool, Optional: true},
+			"name":                        {Type: schema.TypeString, Required: true},
+			"out_filter":                  {Type: schema.TypeString, Optional: true},
+			"redistribute_connected":      {Type: schema.TypeBool, Optional: true},
+			"redistribute_ospf":           {Type: schema.TypeBool, Optional: true},
+			"redistribute_other_bgp":      {Type: schema.TypeBool, Optional: true},
+			"redistribute_rip":            {Type: schema.TypeBool, Optional: true},
+			"redistribute_static":         {Type: schema.TypeBool, Optional: true},
+			"router_id":                   {Type: schema.TypeString, Required: true},
+			"routing_table":               {Type: schema.TypeString, Optional: true},
+		},
+	}
+}
+
+func resourceBgpPeerV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address_families":        {Type: schema.TypeString, Optional: true},
+			"allow_as_in":             {Type: schema.TypeInt, Optional: true},
+			"as_override":             {Type: schema.TypeBool, Optional: true},
+			"cisco_vpls_nlri_len_fmt": {Type: schema.TypeString, Optional: true},
+			"comment":                 {Type: schema.TypeString, Optional: true},
+			"default_originate":       {Type: schema.TypeString, Optional: true},
+			"disabled":                {Type: schema.TypeBool, Optional: true},
+			"hold_time":               {Type: schema.TypeString, Optional: true},
+			"in_filter":               {Type: schema.TypeString, Optional: true},
+			"instance":                {Type: schema.TypeString, Required: true},
+			"keepalive_time":          {Type: schema.TypeString, Optional: true},
+			"max_prefix_limit":        {Type: schema.TypeInt, Optional: true},
+			"max_prefix_restart_time": {Type: schema.TypeString, Optional: true},
+			"multihop":                {Type: schema.TypeBool, Optional: true},
+			"name":                    {Type: schema.TypeString, Required: true},
+			"nexthop_choice":          {Type: schema.TypeString, Optional: true},
+			"out_filter":              {Type: schema.TypeString, Optional: true},
+			"passive":                 {Type: schema.TypeBool, Optional: true},
+			"remote_address":          {Type: schema.TypeString, Required: true},
+			"remote_as":               {Type: schema.TypeInt, Required: true},
+			"remote_port":             {Type: schema.TypeInt, Optional: true},
+			"remove_private_as":       {Type: schema.TypeBool, Optional: true},
+			"route_reflect":           {Type: schema.TypeBool, Optional: true},
+			"tcp_md5_key":             {Type: schema.TypeString, Optional: true},
+			"ttl":                     {Type: schema.TypeString, Optional: true},
+			"update_source":           {Type: schema.TypeString, Optional: true},
+			"use_bfd":                 {Type: schema.TypeBool, Optional: true},
+		},
+	}
+}
+
+func resourceBridgeInterfaceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"admin_mac": {Type: schema.TypeString, Optional: true},
+			"auto_mac":  {Type: schema.TypeBool, Optional: true},
+			"comment":   {Type: schema.TypeString, Optional: true},
+			"disabled":  {Type: schema.TypeBool, Optional: true},
+			"mtu":       {Type: schema.TypeInt, Optional: true},
+			"name":      {Type: schema.TypeString, Required: true},
+		},
+	}
+}
+
+func resourceBridgeInterfacePortV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auto_isolate":            {Type: schema.TypeBool, Optional: true},
+			"bpdu_guard":              {Type: schema.TypeBool, Optional: true},
+			"bridge":                  {Type: schema.TypeString, Required: true},
+			"broadcast_flood":         {Type: schema.TypeBool, Optional: true},
+			"comment":                 {Type: schema.TypeString, Optional: true},
+			"disabled":                {Type: schema.TypeBool, Optional: true},
+			"edge":                    {Type: schema.TypeString, Optional: true},
+			"hardware_offload":        {Type: schema.TypeBool, Optional: true},
+			"horizon":                 {Type: schema.TypeString, Optional: true},
+			"interface":               {Type: schema.TypeString, Required: true},
+			"internal_path_cost":      {Type: schema.TypeInt, Optional: true},
+			"learn":                   {Type: schema.TypeString, Optional: true},
+			"path_cost":               {Type: schema.TypeInt, Optional: true},
+			"point_to_point":          {Type: schema.TypeString, Optional: true},
+			"restricted_role":         {Type: schema.TypeBool, Optional: true},
+			"restricted_tcn":          {Type: schema.TypeBool, Optional: true},
+			"trusted":                 {Type: schema.TypeBool, Optional: true},
+			"unknown_multicast_flood": {Type: schema.TypeBool, Optional: true},
+			"unknown_unicast_flood":   {Type: schema.TypeBool, Optional: true},
+		},
+	}
+}
+
+func resourceDhcpServerV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"add_arp":       {Type: schema.TypeBool, Optional: true},
+			"address_pool":  {Type: schema.TypeString, Optional: true},
+			"authoritative": {Type: schema.TypeString, Optional: true},
+			"disabled":      {Type: schema.TypeBool, Optional: true},
+			"id":            {Type: schema.TypeString, Computed: true},
+			"interface":     {Type: schema.TypeString, Optional: true},
+			"lease_script":  {Type: schema.TypeString, Optional: true},
+			"name":          {Type: schema.TypeString, Required: true},
+		},
+	}
+}
+
+func resourceRecordV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {Type: schema.TypeString, Required: true},
+			"comment": {Type: schema.TypeString, Optional: true},
+			"name":    {Type: schema.TypeString, Required: true},
+			"ttl":     {Type: schema.TypeInt, Optional: true, Computed: true},
+		},
+	}
+}
+
+func resourceInterfaceListV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"comment": {Type: schema.TypeString, Optional: true},
+			"name":    {Type: schema.TypeString, Required: true},
+		},
+	}
+}
+
+func resourceSchedulerV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"interval":   {Type: schema.TypeInt, Optional: true},
+			"name":       {Type: schema.TypeString, Required: true},
+			"on_event":   {Type: schema.TypeString, Required: true},
+			"start_date": {Type: schema.TypeString, Computed: true},
+			"start_time": {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+func resourceScriptV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"dont_require_permissions": {Type: schema.TypeBool, Optional: true},
+			"name":                     {Type: schema.TypeString, Required: true},
+			"owner":                    {Type: schema.TypeString, Required: true},
+			"policy":                   {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"source":                   {Type: schema.TypeString, Required: true},
+		},
+	}
+}
+
+func resourceVlanInterfaceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"comment":         {Type: schema.TypeString, Optional: true},
+			"disabled":        {Type: schema.TypeBool, Optional: true},
+			"interface":       {Type: schema.TypeString, Optional: true},
+			"mtu":             {Type: schema.TypeInt, Optional: true},
+			"name":            {Type: schema.TypeString, Required: true},
+			"use_service_tag": {Type: schema.TypeBool, Optional: true},
+			"vlan_id":         {Type: schema.TypeInt, Optional: true},
+		},
+	}
+}
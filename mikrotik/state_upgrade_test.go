@@ -0,0 +1,35 @@
+package mikrotik
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kube-cloud/terraform-provider-mikrotik/client"
+)
+
+func TestUpgradeStateToMikrotikId(t *testing.T) {
+	upgrader := upgradeStateToMikrotikId(resourceBridgeInterfaceV0(), "/interface/bridge", "name")
+
+	// State already keyed on the MikroTik ID is kept
+	state, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"id": "*1", "name": "br0"}, &client.Mikrotik{})
+	if err != nil || state["id"] != "*1" {
+		t.Errorf("expected the state to be kept, got %v (%v)", state, err)
+	}
+
+	// State without any key attribute cannot be matched
+	if _, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"id": "br0"}, &client.Mikrotik{}); err == nil {
+		t.Error("expected an error for a state without key attributes, got nil")
+	}
+}
+
+func TestUpgradeStateToMikrotikIdDecodesVersion0State(t *testing.T) {
+	upgrader := upgradeStateToMikrotikId(resourceBridgeInterfaceV0(), "/interface/bridge", "name")
+
+	// The state type is the one stored at version 0, without attributes added since
+	if upgrader.Type.HasAttribute("device") {
+		t.Error("expected the version 0 state type not to have the `device` attribute")
+	}
+	if !upgrader.Type.HasAttribute("name") || !upgrader.Type.HasAttribute("id") {
+		t.Errorf("expected the version 0 state type to have `name` and `id`, got %#v", upgrader.Type)
+	}
+}
@@ -0,0 +1,689 @@
+package mikrotik
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipFamily restricts an Address Check to IPv4, IPv6 or both
+type ipFamily int
+
+const (
+	ipAnyFamily ipFamily = iota
+	ipv4Family
+	ipv6Family
+)
+
+// macAddressPattern matches a MAC Address as printed by RouterOS (e.g. 74:4D:28:F3:A7:16)
+var macAddressPattern = regexp.MustCompile(`^[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}$`)
+
+// hexPattern matches Bytes written in Hexadecimal, optionally prefixed by 0x or separated by colons (e.g. 0x0a0b or 0a:0b)
+var hexPattern = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{2}(:?[0-9a-fA-F]{2})*$`)
+
+// durationPattern matches a RouterOS Duration (e.g. 30s, 1h30m, 1w2d, 500ms, 00:10:00 or 1d00:10:00)
+var durationPattern = regexp.MustCompile(`^(\d+w)?(\d+d)?((\d+h)?(\d+m)?(\d+s)?(\d+ms)?|\d+:\d{2}:\d{2}(\.\d+)?)$`)
+
+// dnsNameLabelPattern matches a Label of a DNS Name (e.g. www in www.example.com)
+var dnsNameLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+
+// menuPathPattern matches a Menu Path (e.g. /ip/firewall or /interface/bridge/port)
+var menuPathPattern = regexp.MustCompile(`^(/[a-z0-9][a-z0-9-]*)+$`)
+
+// ipProtocols lists the IP Protocol Names known by RouterOS
+var ipProtocols = []string{
+	"dccp", "ddp", "egp", "encap", "etherip", "ggp", "gre", "hmp", "icmp", "icmpv6", "idpr-cmtp", "igmp",
+	"ipencap", "ipip", "ipsec-ah", "ipsec-esp", "ipv6", "ipv6-encap", "ipv6-frag", "ipv6-nonxt", "ipv6-opts",
+	"ipv6-route", "iso-tp4", "l2tp", "ospf", "pim", "pup", "rdp", "rspf", "rsvp", "sctp", "st", "tcp", "udp",
+	"udp-lite", "vmtp", "vrrp", "xns-idp", "xtp",
+}
+
+// interfaceArpModes lists the ARP Modes of an Interface
+var interfaceArpModes = []string{"enabled", "disabled", "reply-only", "proxy-arp", "local-proxy-arp"}
+
+// Validate an IP Address (IPv4 or IPv6)
+var validateIpAddress = validateString(checkIpAddress(ipAnyFamily))
+
+// Validate an IPv4 Address
+var validateIpv4Address = validateString(checkIpAddress(ipv4Family))
+
+// Validate an IP Address or Prefix (e.g. 192.168.88.0/24 or 10.0.0.1)
+var validateIpAddressOrPrefix = validateString(checkIpPrefix(ipAnyFamily, true))
+
+// Validate an IPv4 Address with its Prefix Length (e.g. 192.168.88.1/24)
+var validateIpv4Prefix = validateString(checkIpPrefix(ipv4Family, false))
+
+// Validate an IPv6 Prefix (e.g. 2001:db8::/48)
+var validateIpv6Prefix = validateString(checkIpPrefix(ipv6Family, false))
+
+// Validate an IPv6 Address with an optional Prefix Length (e.g. 2001:db8::1/64)
+var validateIpv6AddressOrPrefix = validateString(checkIpPrefix(ipv6Family, true))
+
+// Validate a Firewall Address Matcher: Address, Prefix or Range, optionally negated (e.g. !10.0.0.1-10.0.0.9)
+var validateFirewallAddress = validateString(checkNegatable(checkIpAddressOrRange(ipAnyFamily)))
+
+// Validate a Firewall Address List Entry: Address, Prefix, Range or DNS Name (e.g. 10.0.0.0/8 or host.lan)
+var validateFirewallListAddress = validateString(checkOneOf(checkDnsName, checkIpAddressOrRange(ipAnyFamily)))
+
+// Validate a Comma Separated List of IPv4 Addresses, Prefixes or Ranges (e.g. Pool Ranges)
+var validateIpv4Ranges = validateString(checkList(checkIpAddressOrRange(ipv4Family)))
+
+// Validate a Comma Separated List of IP Addresses or Prefixes
+var validateIpAddressOrPrefixList = validateString(checkList(checkIpPrefix(ipAnyFamily, true)))
+
+// Validate an optional Comma Separated List of IPv4 Addresses (e.g. DNS Servers)
+var validateIpv4AddressList = validateString(checkOptional(checkList(checkIpAddress(ipv4Family))))
+
+// Validate an optional MAC Address
+var validateMacAddress = validateString(checkOptional(checkMacAddress))
+
+// Validate an optional Firewall MAC Address Matcher, optionally negated
+var validateFirewallMacAddress = validateString(checkOptional(checkNegatable(checkMacAddress)))
+
+// Validate a RouterOS Identifier (Name of an Interface, Chain, Pool, List, ...)
+var validateName = validateString(checkName)
+
+// Validate an optional RouterOS Identifier
+var validateOptionalName = validateString(checkOptional(checkName))
+
+// Validate a Firewall Protocol Matcher: Protocol Name or Number, optionally negated
+var validateFirewallProtocol = validateString(checkOptional(checkNegatable(checkProtocol)))
+
+// Validate an IPSec Policy Protocol Matcher: `all`, Protocol Name or Number, optionally negated
+var validateIpsecPolicyProtocol = validateString(checkNegatable(checkOneOf(checkEnum("all"), checkProtocol)))
+
+// Validate an optional Port Set (e.g. 22,80,8000-8080), optionally negated
+var validatePortSet = validateNumberSet(0, 65535)
+
+// Validate a Port Number (0 means unset)
+var validatePort = validation.ToDiagFunc(validation.IsPortNumberOrZero)
+
+// Validate an MTU
+var validateMtu = validation.ToDiagFunc(validation.IntBetween(64, 65535))
+
+// Validate a VLAN Identifier
+var validateVlanId = validation.ToDiagFunc(validation.IntBetween(1, 4094))
+
+/**
+ * Function used to build a Diagnostics Validation Function from a String Check
+ */
+func validateString(check func(value string) error) schema.SchemaValidateDiagFunc {
+
+	// Build Validation Function
+	return validation.ToDiagFunc(func(i interface{}, k string) ([]string, []error) {
+
+		// Get String Value
+		value, ok := i.(string)
+
+		// If Value is not a String
+		if !ok {
+
+			// Return Error
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		// If Check Fails
+		if err := check(value); err != nil {
+
+			// Return Error
+			return nil, []error{fmt.Errorf("invalid value for %s: %w", k, err)}
+		}
+
+		// Return no Error
+		return nil, nil
+	})
+}
+
+/**
+ * Function used to build a Diagnostics Validation Function accepting one of the given Values
+ */
+func validateEnum(values ...string) schema.SchemaValidateDiagFunc {
+
+	// Build Validation Function
+	return validateString(checkEnum(values...))
+}
+
+/**
+ * Function used to build a Diagnostics Validation Function accepting a Comma Separated List of the given Values
+ */
+func validateEnumList(values ...string) schema.SchemaValidateDiagFunc {
+
+	// Build Validation Function
+	return validateString(checkList(checkEnum(values...)))
+}
+
+/**
+ * Function used to build a Diagnostics Validation Function accepting a RouterOS Duration or one of the given Keywords
+ */
+func validateDuration(keywords ...string) schema.SchemaValidateDiagFunc {
+
+	// Build Validation Function
+	return validateString(checkDuration(keywords...))
+}
+
+/**
+ * Function used to build a Diagnostics Validation Function accepting a Number between min and max or one of the given Keywords
+ */
+func validateNumberOrKeyword(min int64, max int64, keywords ...string) schema.SchemaValidateDiagFunc {
+
+	// Build Validation Function
+	return validateString(checkNumberOrKeyword(min, max, keywords...))
+}
+
+/**
+ * Function used to build a Diagnostics Validation Function accepting a Set of Numbers and Ranges (e.g. `10,20,30-50`)
+ */
+func validateNumberSet(min int64, max int64) schema.SchemaValidateDiagFunc {
+
+	// Build Validation Function
+	return validateString(checkOptional(checkNegatable(checkList(checkNumberRange(min, max)))))
+}
+
+/**
+ * Function used to Check an IP Address of the given Family
+ */
+func checkIpAddress(family ipFamily) func(value string) error {
+	return func(value string) error {
+
+		// Parse Address
+		_, err := parseIp(value, family)
+
+		// Return Result
+		return err
+	}
+}
+
+/**
+ * Function used to Check an IP Prefix (Host Bits are allowed, e.g. 192.168.88.1/24) of the given Family.
+ * With allowAddress, a bare Address is accepted too.
+ */
+func checkIpPrefix(family ipFamily, allowAddress bool) func(value string) error {
+	return func(value string) error {
+
+		// If Value has no Prefix Length
+		if !strings.Contains(value, "/") {
+
+			// If bare Address is not allowed
+			if !allowAddress {
+
+				// Return Error
+				return fmt.Errorf("expected an address with prefix length (e.g. 192.168.88.1/24), got `%s`", value)
+			}
+
+			// Check Address
+			return checkIpAddress(family)(value)
+		}
+
+		// Split Address and Prefix Length
+		parts := strings.SplitN(value, "/", 2)
+
+		// Parse Address
+		ip, err := parseIp(parts[0], family)
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return err
+		}
+
+		// Compute Maximal Prefix Length
+		bits := 128
+		if ip.To4() != nil && !strings.Contains(parts[0], ":") {
+			bits = 32
+		}
+
+		// Parse Prefix Length
+		length, err := strconv.Atoi(parts[1])
+
+		// If Prefix Length is invalid
+		if err != nil || length < 0 || length > bits {
+
+			// Return Error
+			return fmt.Errorf("invalid prefix length in `%s`, expected 0 to %d", value, bits)
+		}
+
+		// Return no Error
+		return nil
+	}
+}
+
+/**
+ * Function used to Check an IP Address, Prefix or Range (e.g. 10.0.0.1-10.0.0.9) of the given Family
+ */
+func checkIpAddressOrRange(family ipFamily) func(value string) error {
+	return func(value string) error {
+
+		// If Value is not a Range
+		if !strings.Contains(value, "-") {
+
+			// Check Address or Prefix
+			return checkIpPrefix(family, true)(value)
+		}
+
+		// Split Range Bounds
+		bounds := strings.SplitN(value, "-", 2)
+
+		// Parse Range Start
+		start, err := parseIp(bounds[0], family)
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return err
+		}
+
+		// Parse Range End
+		end, err := parseIp(bounds[1], family)
+
+		// If There is Error
+		if err != nil {
+
+			// Return Error
+			return err
+		}
+
+		// If Bounds are not of the same Family
+		if (start.To4() == nil) != (end.To4() == nil) {
+
+			// Return Error
+			return fmt.Errorf("range `%s` mixes IPv4 and IPv6 addresses", value)
+		}
+
+		// If Range is reversed
+		if bytes.Compare(start.To16(), end.To16()) > 0 {
+
+			// Return Error
+			return fmt.Errorf("range `%s` starts after it ends", value)
+		}
+
+		// Return no Error
+		return nil
+	}
+}
+
+/**
+ * Function used to Check a MAC Address
+ */
+func checkMacAddress(value string) error {
+
+	// If Value is not a MAC Address
+	if !macAddressPattern.MatchString(value) {
+
+		// Return Error
+		return fmt.Errorf("expected a MAC address (e.g. 74:4D:28:F3:A7:16), got `%s`", value)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check Bytes written in Hexadecimal
+ */
+func checkHex(value string) error {
+
+	// If Value is not Hexadecimal
+	if !hexPattern.MatchString(value) {
+
+		// Return Error
+		return fmt.Errorf("expected bytes in hexadecimal (e.g. 0x0a0b or 0a:0b), got `%s`", value)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check a RouterOS Duration or one of the given Keywords
+ */
+func checkDuration(keywords ...string) func(value string) error {
+	return func(value string) error {
+
+		// If Value is a Keyword
+		for _, keyword := range keywords {
+			if value == keyword {
+				return nil
+			}
+		}
+
+		// If Value is not a Duration
+		if value == "" || !durationPattern.MatchString(value) {
+
+			// Build Error Message
+			message := fmt.Sprintf("expected a duration (e.g. 30s, 1h30m, 1d or 00:10:00), got `%s`", value)
+			if len(keywords) > 0 {
+				message = fmt.Sprintf("expected a duration (e.g. 30s, 1h30m, 1d or 00:10:00) or one of %s, got `%s`", strings.Join(keywords, ", "), value)
+			}
+
+			// Return Error
+			return fmt.Errorf("%s", message)
+		}
+
+		// Return no Error
+		return nil
+	}
+}
+
+/**
+ * Function used to Check a Range of RouterOS Durations (e.g. 3m20s-10m), or a single Duration
+ */
+func checkDurationRange(value string) error {
+
+	// For each Bound
+	for _, bound := range strings.SplitN(value, "-", 2) {
+
+		// If Bound is not a Duration
+		if checkDuration()(bound) != nil {
+
+			// Return Error
+			return fmt.Errorf("expected a duration or a range of durations (e.g. 3m20s-10m), got `%s`", value)
+		}
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check a Number between min and max, or one of the given Keywords
+ */
+func checkNumberOrKeyword(min int64, max int64, keywords ...string) func(value string) error {
+	return func(value string) error {
+
+		// If Value is a Keyword
+		for _, keyword := range keywords {
+			if value == keyword {
+				return nil
+			}
+		}
+
+		// Parse Number
+		number, err := strconv.ParseInt(value, 10, 64)
+
+		// If Value is not a Number in Range
+		if err != nil || number < min || number > max {
+
+			// Return Error
+			return fmt.Errorf("expected %s or a number from %d to %d, got `%s`", strings.Join(keywords, ", "), min, max, value)
+		}
+
+		// Return no Error
+		return nil
+	}
+}
+
+/**
+ * Function used to Check a Number or a Range of Numbers (e.g. 8000-8080) between min and max
+ */
+func checkNumberRange(min int64, max int64) func(value string) error {
+	return func(value string) error {
+
+		// Initialize Previous Bound
+		previous := min
+
+		// For each Range Bound
+		for _, bound := range strings.SplitN(value, "-", 2) {
+
+			// Parse Bound
+			number, err := strconv.ParseInt(bound, 10, 64)
+
+			// If Bound is not a Number in Range
+			if err != nil || number < min || number > max {
+
+				// Return Error
+				return fmt.Errorf("expected a number or range from %d to %d, got `%s`", min, max, value)
+			}
+
+			// If Range is reversed
+			if number < previous {
+
+				// Return Error
+				return fmt.Errorf("range `%s` starts after it ends", value)
+			}
+
+			// Keep Bound
+			previous = number
+		}
+
+		// Return no Error
+		return nil
+	}
+}
+
+/**
+ * Function used to Check a RouterOS Identifier (not empty, no control characters)
+ */
+func checkName(value string) error {
+
+	// If Value is Empty
+	if strings.TrimSpace(value) == "" {
+
+		// Return Error
+		return fmt.Errorf("expected a non empty name")
+	}
+
+	// If Value contains a Control Character
+	if strings.IndexFunc(value, unicode.IsControl) >= 0 {
+
+		// Return Error
+		return fmt.Errorf("name `%q` contains control characters", value)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check a DNS Name (e.g. host.lan), whose last Label is not numeric to tell it from an Address
+ */
+func checkDnsName(value string) error {
+
+	// Split Labels (a trailing dot is allowed)
+	labels := strings.Split(strings.TrimSuffix(value, "."), ".")
+
+	// For each Label
+	for _, label := range labels {
+
+		// If Label is not valid
+		if !dnsNameLabelPattern.MatchString(label) {
+
+			// Return Error
+			return fmt.Errorf("`%s` is not a valid DNS name", value)
+		}
+	}
+
+	// If last Label is numeric
+	if strings.Trim(labels[len(labels)-1], "0123456789") == "" {
+
+		// Return Error
+		return fmt.Errorf("`%s` is not a valid DNS name", value)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check a Go Duration (e.g. 10s, 1m30s), empty for none
+ */
+func checkGoDuration(value string) error {
+
+	// If Value is Empty
+	if value == "" {
+		return nil
+	}
+
+	// Parse Duration
+	duration, err := time.ParseDuration(value)
+
+	// If Value is not a non negative Duration
+	if err != nil || duration < 0 {
+
+		// Return Error
+		return fmt.Errorf("expected a duration like 10s or 1m30s, got `%s`", value)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check a Menu Path (e.g. /ip/firewall), empty for the whole Configuration
+ */
+func checkMenuPath(value string) error {
+
+	// If Value is Empty
+	if value == "" {
+		return nil
+	}
+
+	// If Value is not an absolute, slash separated Path
+	if !menuPathPattern.MatchString(value) {
+
+		// Return Error
+		return fmt.Errorf("expected a menu path like /ip/firewall, got `%s`", value)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check an IP Protocol Name or Number
+ */
+func checkProtocol(value string) error {
+
+	// If Value is a Protocol Number
+	if number, err := strconv.Atoi(value); err == nil && number >= 0 && number <= 255 {
+		return nil
+	}
+
+	// Check Protocol Name
+	if err := checkEnum(ipProtocols...)(value); err != nil {
+
+		// Return Error
+		return fmt.Errorf("expected a protocol name (e.g. tcp, udp, icmp) or number from 0 to 255, got `%s`", value)
+	}
+
+	// Return no Error
+	return nil
+}
+
+/**
+ * Function used to Check a Value is one of the given Values
+ */
+func checkEnum(values ...string) func(value string) error {
+	return func(value string) error {
+
+		// If Value is Allowed
+		for _, allowed := range values {
+			if value == allowed {
+				return nil
+			}
+		}
+
+		// Return Error
+		return fmt.Errorf("expected one of %s, got `%s`", strings.Join(values, ", "), value)
+	}
+}
+
+/**
+ * Function used to Check a Value passes at least one of the given Checks (the last Error is returned)
+ */
+func checkOneOf(checks ...func(value string) error) func(value string) error {
+	return func(value string) (err error) {
+
+		// For each Check
+		for _, check := range checks {
+
+			// If Check Passes
+			if err = check(value); err == nil {
+				return nil
+			}
+		}
+
+		// Return last Error
+		return err
+	}
+}
+
+/**
+ * Function used to Check a Comma Separated List whose Items all pass the given Check
+ */
+func checkList(check func(value string) error) func(value string) error {
+	return func(value string) error {
+
+		// For each Item
+		for _, item := range strings.Split(value, ",") {
+
+			// Check Item
+			if err := check(strings.TrimSpace(item)); err != nil {
+
+				// Return Error
+				return err
+			}
+		}
+
+		// Return no Error
+		return nil
+	}
+}
+
+/**
+ * Function used to Check a Value optionally negated with a leading `!`
+ */
+func checkNegatable(check func(value string) error) func(value string) error {
+	return func(value string) error {
+
+		// Check Value without Negation
+		return check(strings.TrimPrefix(value, "!"))
+	}
+}
+
+/**
+ * Function used to Check a Value that may be left Empty
+ */
+func checkOptional(check func(value string) error) func(value string) error {
+	return func(value string) error {
+
+		// If Value is Empty
+		if value == "" {
+			return nil
+		}
+
+		// Check Value
+		return check(value)
+	}
+}
+
+/**
+ * Function used to Parse an IP Address of the given Family
+ */
+func parseIp(value string, family ipFamily) (net.IP, error) {
+
+	// Parse Address
+	ip := net.ParseIP(value)
+
+	// Check Family
+	switch {
+	case ip == nil:
+		return nil, fmt.Errorf("expected an IP address, got `%s`", value)
+	case family == ipv4Family && (ip.To4() == nil || strings.Contains(value, ":")):
+		return nil, fmt.Errorf("expected an IPv4 address, got `%s`", value)
+	case family == ipv6Family && !strings.Contains(value, ":"):
+		return nil, fmt.Errorf("expected an IPv6 address, got `%s`", value)
+	}
+
+	// Return Address
+	return ip, nil
+}
@@ -0,0 +1,198 @@
+package mikrotik
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestValidatorChecks(t *testing.T) {
+	cases := []struct {
+		name    string
+		check   func(value string) error
+		valid   []string
+		invalid []string
+	}{
+		{
+			name:    "ipv4 address",
+			check:   checkIpAddress(ipv4Family),
+			valid:   []string{"192.168.88.1", "0.0.0.0"},
+			invalid: []string{"", "192.168.88.256", "::ffff:10.0.0.1", "10.0.0.1/24"},
+		},
+		{
+			name:    "ipv4 prefix",
+			check:   checkIpPrefix(ipv4Family, false),
+			valid:   []string{"192.168.88.1/24", "10.0.0.0/8", "10.0.0.1/32"},
+			invalid: []string{"192.168.88.1", "10.0.0.0/33", "10.0.0.0/x", "2001:db8::1/64"},
+		},
+		{
+			name:    "ipv6 address or prefix",
+			check:   checkIpPrefix(ipv6Family, true),
+			valid:   []string{"2001:db8::1/64", "2001:db8::1", "::/0"},
+			invalid: []string{"192.168.88.1/24", "2001:db8::1/129", "2001:db8:::1"},
+		},
+		{
+			name:    "hex",
+			check:   checkHex,
+			valid:   []string{"0x0a0b", "0A0B", "0a:0b:ff", "00"},
+			invalid: []string{"", "0x", "0a0", "0x0g", "0a::0b"},
+		},
+		{
+			name:    "firewall address",
+			check:   checkNegatable(checkIpAddressOrRange(ipAnyFamily)),
+			valid:   []string{"10.0.0.1", "!10.0.0.0/8", "10.0.0.1-10.0.0.9", "2001:db8::1-2001:db8::9"},
+			invalid: []string{"10.0.0.9-10.0.0.1", "10.0.0.1-2001:db8::1", "10.0.0.1-", "host.lan"},
+		},
+		{
+			name:    "firewall list address",
+			check:   checkOneOf(checkDnsName, checkIpAddressOrRange(ipAnyFamily)),
+			valid:   []string{"10.0.0.1", "10.0.0.0/8", "10.0.0.1-10.0.0.9", "2001:db8::/32", "host.lan", "cdn.example.com."},
+			invalid: []string{"", "10.0.0.300", "10.0.0.0/33", "host name", "-host.lan", "!10.0.0.1"},
+		},
+		{
+			name:    "pool ranges",
+			check:   checkList(checkIpAddressOrRange(ipv4Family)),
+			valid:   []string{"172.16.0.6-172.16.0.12,172.16.0.50-172.16.0.60", "10.0.0.0/24", "10.0.0.5"},
+			invalid: []string{"", "172.16.0.6-172.16.0.12,", "172.16.0.6..172.16.0.12"},
+		},
+		{
+			name:    "mac address",
+			check:   checkMacAddress,
+			valid:   []string{"74:4D:28:F3:A7:16", "01:23:45:67:89:ab"},
+			invalid: []string{"74-4D-28-F3-A7-16", "744D.28F3.A716", "74:4D:28:F3:A7", "74:4D:28:F3:A7:1G"},
+		},
+		{
+			name:    "duration range",
+			check:   checkDurationRange,
+			valid:   []string{"3m20s-10m", "200s-600s", "30s"},
+			invalid: []string{"", "3m20s-", "-10m", "3m-10x"},
+		},
+		{
+			name:    "duration",
+			check:   checkDuration("disable-dpd"),
+			valid:   []string{"30s", "1h30m", "1w2d", "500ms", "00:10:00", "1d00:10:00", "disable-dpd"},
+			invalid: []string{"", "1x", "30 s", "1h30", "disable"},
+		},
+		{
+			name:    "port set",
+			check:   checkOptional(checkNegatable(checkList(checkNumberRange(0, 65535)))),
+			valid:   []string{"", "22", "22,80,8000-8080", "!53"},
+			invalid: []string{"65536", "8080-8000", "http", "22,,80"},
+		},
+		{
+			name:    "number or keyword",
+			check:   checkNumberOrKeyword(0, 255, "default"),
+			valid:   []string{"default", "0", "255"},
+			invalid: []string{"256", "-1", "auto"},
+		},
+		{
+			name:    "protocol",
+			check:   checkNegatable(checkProtocol),
+			valid:   []string{"tcp", "!udp", "icmpv6", "47"},
+			invalid: []string{"tpc", "256", "all"},
+		},
+		{
+			name:    "enum list",
+			check:   checkList(checkEnum("modp1024", "modp2048")),
+			valid:   []string{"modp2048", "modp2048,modp1024"},
+			invalid: []string{"modp2048,modp9999", "MODP2048"},
+		},
+		{
+			name:    "name",
+			check:   checkName,
+			valid:   []string{"ether1", "vlan 10", "bridge-lan"},
+			invalid: []string{"", "   ", "ether\n1"},
+		},
+		{
+			name:    "go duration",
+			check:   checkGoDuration,
+			valid:   []string{"", "10s", "1m30s", "0s"},
+			invalid: []string{"10", "-5s", "1d"},
+		},
+		{
+			name:    "menu path",
+			check:   checkMenuPath,
+			valid:   []string{"", "/ip/firewall", "/interface/bridge/port", "/ipv6"},
+			invalid: []string{"ip/firewall", "/ip/", "/ip firewall", "/ip//dns"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, value := range tc.valid {
+				if err := tc.check(value); err != nil {
+					t.Errorf("expected `%s` to be valid, got: %v", value, err)
+				}
+			}
+			for _, value := range tc.invalid {
+				if err := tc.check(value); err == nil {
+					t.Errorf("expected `%s` to be invalid, got nil", value)
+				}
+			}
+		})
+	}
+}
+
+func TestProviderSchemaValidation(t *testing.T) {
+	provider := Provider(nil)
+	if err := provider.InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		resource string
+		config   map[string]interface{}
+		valid    bool
+	}{
+		{
+			resource: "mikrotik_firewall_rule",
+			config:   map[string]interface{}{"chain": "forward", "action": "accept"},
+			valid:    true,
+		},
+		{
+			resource: "mikrotik_firewall_rule",
+			config:   map[string]interface{}{"chain": "forward", "action": "acept"},
+		},
+		{
+			resource: "mikrotik_bridge_interface_port",
+			config:   map[string]interface{}{"bridge": "br0", "interface": "ether2", "learn": "maybe"},
+		},
+		{
+			resource: "mikrotik_bridge_interface_port",
+			config:   map[string]interface{}{"bridge": "br0", "interface": "ether2", "edge": "yess"},
+		},
+		{
+			resource: "mikrotik_dhcp_lease",
+			config:   map[string]interface{}{"address": "10.0.0.10", "macaddress": "01:23:45:67:89"},
+		},
+		{
+			resource: "mikrotik_ip_address",
+			config:   map[string]interface{}{"address": "10.0.0.1", "interface": "ether1"},
+		},
+		{
+			resource: "mikrotik_dhcp_option",
+			config:   map[string]interface{}{"name": "tftp", "code": 66, "string_value": "10.0.0.1"},
+			valid:    true,
+		},
+		{
+			resource: "mikrotik_dhcp_option",
+			config:   map[string]interface{}{"name": "tftp", "code": 66},
+		},
+		{
+			resource: "mikrotik_dhcp_option",
+			config:   map[string]interface{}{"name": "tftp", "code": 66, "value": "'10.0.0.1'", "hex_value": "0a000001"},
+		},
+		{
+			resource: "mikrotik_ip_address",
+			config:   map[string]interface{}{"address": "10.0.0.1/24", "interface": "ether1"},
+			valid:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		diags := provider.ResourcesMap[tc.resource].Validate(terraform.NewResourceConfigRaw(tc.config))
+		if diags.HasError() == tc.valid {
+			t.Errorf("%s %v: expected valid=%t, got diagnostics: %v", tc.resource, tc.config, tc.valid, diags)
+		}
+	}
+}